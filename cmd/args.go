@@ -6,6 +6,7 @@ package cmd
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -42,3 +43,25 @@ func checkArgs(cmd *cobra.Command, args []string, expected int, typ checkType, v
 	}
 	return nil
 }
+
+// parsePullRequestNumber parses s as the pull request or issue number, with or without the "#" prefix.
+func parsePullRequestNumber(s string) (int, error) {
+	number, err := strconv.Atoi(strings.TrimPrefix(s, "#"))
+	if err != nil || number <= 0 {
+		return 0, fmt.Errorf("invalid number %q: must be the positive integer", s)
+	}
+	return number, nil
+}
+
+// parseRepositoryNumber parses the <owner/repo> <number> arguments.
+func parseRepositoryNumber(args []string) (owner, repo string, number int, err error) {
+	owner, repo, err = splitOwnerRepo(args[0])
+	if err != nil {
+		return "", "", 0, err
+	}
+	number, err = parsePullRequestNumber(args[1])
+	if err != nil {
+		return "", "", 0, err
+	}
+	return owner, repo, number, nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/zchee/ghctl/pkg/git"
+)
+
+// editorHint is appended to the text opened in the editor, and removed from the result.
+const editorHint = "\n<!-- Write the body above. This comment is removed. Leave the body empty to abort. -->\n"
+
+// errEditAborted is returned by editBody when the edited body is empty.
+var errEditAborted = errors.New("aborted due to the empty body")
+
+// readBodyFile reads the body text from the fname file. If fname is "-", reads from in.
+func readBodyFile(fname string, in io.Reader) (string, error) {
+	var data []byte
+	var err error
+	if fname == "-" {
+		data, err = io.ReadAll(in)
+	} else {
+		data, err = os.ReadFile(fname)
+	}
+	if err != nil {
+		return "", fmt.Errorf("could not read body from %s: %w", fname, err)
+	}
+	return string(data), nil
+}
+
+// editorCommand returns the editor command from $GHCTL_EDITOR, $VISUAL, $EDITOR or git core.editor config.
+func editorCommand(ctx context.Context) string {
+	for _, env := range []string{"GHCTL_EDITOR", "VISUAL", "EDITOR"} {
+		if editor := os.Getenv(env); editor != "" {
+			return editor
+		}
+	}
+	if editor := git.Config(ctx, "core.editor"); editor != "" {
+		return editor
+	}
+	return "vi"
+}
+
+// editBody opens initial in the editor, and returns the edited text without the editor hint.
+// It returns errEditAborted if the edited text is empty.
+func editBody(ctx context.Context, initial string) (string, error) {
+	f, err := os.CreateTemp("", "ghctl-body-*.md")
+	if err != nil {
+		return "", fmt.Errorf("could not create temporary file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := io.WriteString(f, initial+editorHint); err != nil {
+		f.Close()
+		return "", fmt.Errorf("could not write temporary file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return "", fmt.Errorf("could not close temporary file: %w", err)
+	}
+
+	// run via the shell so the editor command can have the arguments, such as "code --wait"
+	editor := editorCommand(ctx)
+	cmd := exec.CommandContext(ctx, "sh", "-c", editor+` "$1"`, "sh", f.Name())
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("could not run editor %q: %w", editor, err)
+	}
+
+	data, err := os.ReadFile(f.Name())
+	if err != nil {
+		return "", fmt.Errorf("could not read temporary file: %w", err)
+	}
+	body := strings.TrimSpace(strings.Replace(string(data), strings.TrimPrefix(editorHint, "\n"), "", 1))
+	if body == "" {
+		return "", errEditAborted
+	}
+
+	return body, nil
+}
+
+// bodyFromFlags returns the body from the mutually exclusive --body, --body-file and --editor flags.
+// The editor opens initial. It reports false if none of the flags is set, and returns errEditAborted if the edited body is empty.
+func bodyFromFlags(ctx context.Context, in io.Reader, body, bodyFile string, editor bool, initial string) (string, bool, error) {
+	nbody := 0
+	for _, set := range []bool{body != "", bodyFile != "", editor} {
+		if set {
+			nbody++
+		}
+	}
+	switch {
+	case nbody > 1:
+		return "", false, errors.New("--body, --body-file and --editor flags are mutually exclusive")
+	case bodyFile != "":
+		body, err := readBodyFile(bodyFile, in)
+		return body, err == nil, err
+	case editor:
+		body, err := editBody(ctx, initial)
+		return body, err == nil, err
+	}
+	return body, body != "", nil
+}
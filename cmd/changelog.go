@@ -0,0 +1,309 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/google/go-github/v38/github"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/zchee/ghctl/pkg/config"
+	"github.com/zchee/ghctl/pkg/spin"
+)
+
+type changelogCmd struct {
+	ioStreams *IOStreams
+
+	from       string
+	to         string
+	configFile string
+}
+
+func init() {
+	rootCmd.AddCommand(newCmdChangelog())
+}
+
+func newCmdChangelog() *cobra.Command {
+	c := &changelogCmd{
+		ioStreams: defaultIOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "changelog <owner/repo>",
+		Short: "Generates the Markdown changelog from the pull requests merged between two refs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkArgs(cmd, args, 1, exactArgs, "<owner/repo>"); err != nil {
+				return err
+			}
+			owner, repo, err := splitOwnerRepo(args[0])
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			return c.runChangelog(ctx, owner, repo)
+		},
+		ValidArgsFunction: completeRepositoryNames,
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&c.from, "from", "", "the ref which the changelog starts from, such as the previous release tag (required)")
+	f.StringVar(&c.to, "to", "", "the ref which the changelog ends at (default: default branch of the repository)")
+	f.StringVar(&c.configFile, "config", "", "path of the config file which has the changelog sections (default: config.json in the config directory)")
+
+	return cmd
+}
+
+// changelogEntry represents the merged pull request in the changelog.
+type changelogEntry struct {
+	pr         *github.PullRequest
+	firstTimer bool
+}
+
+func (c *changelogCmd) runChangelog(ctx context.Context, owner, repo string) error {
+	if c.from == "" {
+		return errors.New("--from flag must be not empty")
+	}
+
+	cfg, err := config.Load(c.configFile)
+	if err != nil {
+		return err
+	}
+	changelogCfg := cfg.Changelog
+	if len(changelogCfg.Sections) == 0 {
+		changelogCfg = config.DefaultChangelog
+	}
+	if changelogCfg.OtherTitle == "" {
+		changelogCfg.OtherTitle = config.DefaultChangelog.OtherTitle
+	}
+
+	client := newClient(ctx)
+
+	to := c.to
+	if to == "" {
+		repository, _, err := client.Repositories.Get(ctx, owner, repo)
+		if err != nil {
+			return fmt.Errorf("could not get %s/%s repository: %w", owner, repo, IsRateLimitError(err))
+		}
+		to = repository.GetDefaultBranch()
+	}
+
+	progress := spin.NewProgress(c.ioStreams.ErrOut)
+	task := progress.AddTask("fetching changes", 0)
+	progress.Start()
+	defer progress.Stop()
+
+	commits, err := compareCommitSHAs(ctx, client, owner, repo, c.from, to)
+	if err != nil {
+		return err
+	}
+	task.SetTotal(len(commits))
+
+	prs, err := pullRequestsOfCommits(ctx, client, owner, repo, commits, task)
+	if err != nil {
+		return err
+	}
+
+	entries := changelogEntries(prs, changelogCfg.ExcludeLabels)
+	if err := markFirstTimers(ctx, client, owner, repo, entries, progress.AddTask("checking first-time contributors", 0)); err != nil {
+		return err
+	}
+	progress.Stop()
+
+	return writeChangelog(c.ioStreams.Out, owner, repo, c.from, to, entries, changelogCfg)
+}
+
+// compareCommitSHAs returns the set of commit SHAs between base and head.
+func compareCommitSHAs(ctx context.Context, client *github.Client, owner, repo, base, head string) (map[string]bool, error) {
+	shas := make(map[string]bool)
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		comparison, resp, err := client.Repositories.CompareCommits(ctx, owner, repo, base, head, opts)
+		if err != nil {
+			return nil, fmt.Errorf("could not compare %s...%s: %w", base, head, IsRateLimitError(err))
+		}
+		for _, commit := range comparison.Commits {
+			shas[commit.GetSHA()] = true
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return shas, nil
+}
+
+// pullRequestsOfCommits returns the merged pull requests which merge commit is in commits.
+// The pull requests are looked up by each commit, so the cost is bounded by the range, not the repository history.
+func pullRequestsOfCommits(ctx context.Context, client *github.Client, owner, repo string, commits map[string]bool, task *spin.Task) ([]*github.PullRequest, error) {
+	var mu sync.Mutex
+	seen := make(map[int]bool)
+	var prs []*github.PullRequest
+
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := make(chan struct{}, 20) // for concurrency API access limit
+	for sha := range commits {
+		sem <- struct{}{}
+		sha := sha
+		eg.Go(func() error {
+			defer func() { <-sem }()
+
+			result, _, err := client.PullRequests.ListPullRequestsWithCommit(ctx, owner, repo, sha, &github.PullRequestListOptions{State: "closed"})
+			if err != nil {
+				return fmt.Errorf("could not list pull requests of %.7s commit: %w", sha, IsRateLimitError(err))
+			}
+			task.Increment()
+
+			mu.Lock()
+			defer mu.Unlock()
+			for _, pr := range result {
+				if pr.MergedAt == nil || !commits[pr.GetMergeCommitSHA()] || seen[pr.GetNumber()] {
+					continue
+				}
+				seen[pr.GetNumber()] = true
+				prs = append(prs, pr)
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return prs, nil
+}
+
+// changelogEntries returns prs without the excluded labels, sorted by the merged time.
+func changelogEntries(prs []*github.PullRequest, excludeLabels []string) []changelogEntry {
+	var entries []changelogEntry
+	for _, pr := range prs {
+		if hasAnyLabel(pr.Labels, excludeLabels) {
+			continue
+		}
+		entries = append(entries, changelogEntry{pr: pr})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].pr.GetMergedAt().Before(entries[j].pr.GetMergedAt())
+	})
+
+	return entries
+}
+
+// markFirstTimers marks the first entry of each author who has no merged pull request before it in owner/repo.
+// The search requests are paced to stay in the search API rate limit.
+func markFirstTimers(ctx context.Context, client *github.Client, owner, repo string, entries []changelogEntry, task *spin.Task) error {
+	first := make(map[string]int) // index of the first entry of each author
+	var authors []string
+	for i, entry := range entries {
+		login := entry.pr.GetUser().GetLogin()
+		if _, ok := first[login]; !ok {
+			first[login] = i
+			authors = append(authors, login)
+		}
+	}
+	task.SetTotal(len(authors))
+
+	var pacer searchPacer
+	for _, login := range authors {
+		i := first[login]
+		if err := pacer.wait(ctx); err != nil {
+			return err
+		}
+		query := fmt.Sprintf("type:pr repo:%s/%s author:%s is:merged merged:<%s",
+			owner, repo, login, entries[i].pr.GetMergedAt().UTC().Format(searchDateLayout))
+		result, _, err := client.Search.Issues(ctx, query, &github.SearchOptions{ListOptions: github.ListOptions{PerPage: 1}})
+		if err != nil {
+			return fmt.Errorf("could not search merged pull requests of %s: %w", login, IsRateLimitError(err))
+		}
+		entries[i].firstTimer = result.GetTotal() == 0
+		task.Increment()
+	}
+
+	return nil
+}
+
+// hasAnyLabel reports whether labels has any of names, ignoring case.
+func hasAnyLabel(labels []*github.Label, names []string) bool {
+	for _, label := range labels {
+		for _, name := range names {
+			if strings.EqualFold(label.GetName(), name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// writeChangelog writes the Markdown changelog of entries grouped by the cfg sections to w.
+func writeChangelog(w io.Writer, owner, repo, from, to string, entries []changelogEntry, cfg config.Changelog) error {
+	sections := make([][]changelogEntry, len(cfg.Sections)+1) // the last is the other section
+	for _, entry := range entries {
+		idx := len(cfg.Sections)
+		for i, section := range cfg.Sections {
+			if hasAnyLabel(entry.pr.Labels, section.Labels) {
+				idx = i
+				break
+			}
+		}
+		sections[idx] = append(sections[idx], entry)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "## %s\n", to)
+	for i, section := range sections {
+		if len(section) == 0 {
+			continue
+		}
+		title := cfg.OtherTitle
+		if i < len(cfg.Sections) {
+			title = cfg.Sections[i].Title
+		}
+		fmt.Fprintf(&sb, "\n### %s\n\n", title)
+		for _, entry := range section {
+			fmt.Fprintf(&sb, "- %s by @%s in %s\n", entry.pr.GetTitle(), entry.pr.GetUser().GetLogin(), entry.pr.GetHTMLURL())
+		}
+	}
+
+	var contributors []string
+	seen := make(map[string]bool)
+	var firstTimers []changelogEntry
+	for _, entry := range entries {
+		login := entry.pr.GetUser().GetLogin()
+		if !seen[login] {
+			seen[login] = true
+			contributors = append(contributors, "@"+login)
+		}
+		if entry.firstTimer {
+			firstTimers = append(firstTimers, entry)
+		}
+	}
+	if len(contributors) > 0 {
+		sort.Strings(contributors)
+		fmt.Fprintf(&sb, "\n### Contributors\n\n%s\n", strings.Join(contributors, ", "))
+	}
+	if len(firstTimers) > 0 {
+		sb.WriteString("\n### New Contributors\n\n")
+		for _, entry := range firstTimers {
+			fmt.Fprintf(&sb, "- @%s made their first contribution in %s\n", entry.pr.GetUser().GetLogin(), entry.pr.GetHTMLURL())
+		}
+	}
+
+	fmt.Fprintf(&sb, "\n**Full Changelog**: https://github.com/%s/%s/compare/%s...%s\n", owner, repo, from, to)
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
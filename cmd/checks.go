@@ -0,0 +1,174 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/go-github/v38/github"
+)
+
+// checkState represents the normalized state of the commit status or check run.
+type checkState string
+
+const (
+	checkPending checkState = "pending"
+	checkSuccess checkState = "success"
+	checkFailure checkState = "failure"
+	checkSkipped checkState = "skipped"
+)
+
+// checkResult represents the commit status or check run of the commit.
+type checkResult struct {
+	Name        string     `json:"name"`
+	State       checkState `json:"state"`
+	URL         string     `json:"url,omitempty"`
+	StartedAt   time.Time  `json:"started_at,omitempty"`
+	CompletedAt time.Time  `json:"completed_at,omitempty"`
+
+	// checkRunID is the ID of the check run. It is zero for the commit status.
+	checkRunID int64
+	// app is the slug of the GitHub App which created the check run.
+	app string
+}
+
+// Elapsed returns the elapsed time of the check. The running check is measured until now.
+func (r checkResult) Elapsed(now time.Time) time.Duration {
+	if r.StartedAt.IsZero() {
+		return 0
+	}
+	if r.CompletedAt.IsZero() {
+		return now.Sub(r.StartedAt)
+	}
+	return r.CompletedAt.Sub(r.StartedAt)
+}
+
+// listChecks returns the commit statuses and check runs of ref, sorted by the name.
+func listChecks(ctx context.Context, client *github.Client, owner, repo, ref string) ([]checkResult, error) {
+	var checks []checkResult
+
+	statusOpts := &github.ListOptions{PerPage: 100}
+	for {
+		combined, resp, err := client.Repositories.GetCombinedStatus(ctx, owner, repo, ref, statusOpts)
+		if err != nil {
+			return nil, fmt.Errorf("could not get %s/%s@%s combined status: %w", owner, repo, ref, IsRateLimitError(err))
+		}
+		for _, status := range combined.Statuses {
+			check := checkResult{
+				Name:      status.GetContext(),
+				State:     statusCheckState(status.GetState()),
+				URL:       status.GetTargetURL(),
+				StartedAt: status.GetCreatedAt(),
+			}
+			if check.State != checkPending {
+				check.CompletedAt = status.GetUpdatedAt()
+			}
+			checks = append(checks, check)
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		statusOpts.Page = resp.NextPage
+	}
+
+	runOpts := &github.ListCheckRunsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		result, resp, err := client.Checks.ListCheckRunsForRef(ctx, owner, repo, ref, runOpts)
+		if err != nil {
+			return nil, fmt.Errorf("could not list %s/%s@%s check runs: %w", owner, repo, ref, IsRateLimitError(err))
+		}
+		for _, run := range result.CheckRuns {
+			check := checkResult{
+				Name:       run.GetName(),
+				State:      checkRunState(run.GetStatus(), run.GetConclusion()),
+				URL:        run.GetHTMLURL(),
+				checkRunID: run.GetID(),
+				app:        run.GetApp().GetSlug(),
+			}
+			if run.StartedAt != nil {
+				check.StartedAt = run.StartedAt.Time
+			}
+			if run.CompletedAt != nil {
+				check.CompletedAt = run.CompletedAt.Time
+			}
+			checks = append(checks, check)
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		runOpts.Page = resp.NextPage
+	}
+
+	sort.SliceStable(checks, func(i, j int) bool { return checks[i].Name < checks[j].Name })
+
+	return checks, nil
+}
+
+// statusCheckState returns the checkState of the commit status state.
+func statusCheckState(state string) checkState {
+	switch state {
+	case "success":
+		return checkSuccess
+	case "failure", "error":
+		return checkFailure
+	default:
+		return checkPending
+	}
+}
+
+// checkRunState returns the checkState of the check run status and conclusion.
+func checkRunState(status, conclusion string) checkState {
+	if status != "completed" {
+		return checkPending
+	}
+	switch conclusion {
+	case "success":
+		return checkSuccess
+	case "neutral", "skipped", "stale":
+		return checkSkipped
+	default: // failure, cancelled, timed_out, action_required
+		return checkFailure
+	}
+}
+
+// noChecksGrace is the time after the head commit while no reported checks are regarded as pending,
+// since the CI does not register the checks right after the push. After that, the commit has no checks.
+const noChecksGrace = 5 * time.Minute
+
+// headCommitTime returns the committer date of sha, which noChecksGrace is counted from.
+func headCommitTime(ctx context.Context, client *github.Client, owner, repo, sha string) (time.Time, error) {
+	commit, _, err := client.Git.GetCommit(ctx, owner, repo, sha)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("could not get %s/%s@%.7s commit: %w", owner, repo, sha, IsRateLimitError(err))
+	}
+	return commit.GetCommitter().GetDate(), nil
+}
+
+// summarizeChecks returns the overall state of checks and the names of the failed checks.
+// The overall state is failure if any check failed, pending if any check is running, otherwise success.
+// No checks are pending, and the callers which wait for the checks give up after noChecksGrace.
+func summarizeChecks(checks []checkResult) (state checkState, failed []string) {
+	if len(checks) == 0 {
+		return checkPending, nil
+	}
+	state = checkSuccess
+	for _, check := range checks {
+		switch check.State {
+		case checkFailure:
+			failed = append(failed, check.Name)
+		case checkPending:
+			if state == checkSuccess {
+				state = checkPending
+			}
+		}
+	}
+	if len(failed) > 0 {
+		state = checkFailure
+	}
+	return state, failed
+}
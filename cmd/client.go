@@ -6,11 +6,16 @@ package cmd
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"os"
+	"os/exec"
+	"strings"
 
 	"github.com/google/go-github/v38/github"
 	"golang.org/x/oauth2"
+
+	"github.com/zchee/ghctl/pkg/term"
 )
 
 // IOStreams provides the standard names for iostreams.
@@ -21,19 +26,151 @@ type IOStreams struct {
 	Out io.Writer
 	// ErrOut think, os.Stderr.
 	ErrOut io.Writer
+
+	pager    *pagerWriter
+	pagerOut io.Writer
 }
 
 var (
 	defaultIOStreams = &IOStreams{In: os.Stdin, Out: os.Stdout, ErrOut: os.Stderr}
 )
 
-func newClient(ctx context.Context) *github.Client {
+// defaultPager is the pager command used if $GHCTL_PAGER and $PAGER are not set.
+const defaultPager = "less -FRX"
+
+// IsStdoutTTY reports whether the Out is connected to the terminal.
+func (s *IOStreams) IsStdoutTTY() bool {
+	f, ok := s.Out.(*os.File)
+	return ok && term.IsTerminal(f.Fd())
+}
+
+// IsStderrTTY reports whether the ErrOut is connected to the terminal.
+func (s *IOStreams) IsStderrTTY() bool {
+	f, ok := s.ErrOut.(*os.File)
+	return ok && term.IsTerminal(f.Fd())
+}
+
+// ColorEnabled reports whether the output to the Out can be colored.
+// It is true if the Out is the terminal or the pager, unless $NO_COLOR is set.
+func (s *IOStreams) ColorEnabled() bool {
+	if _, ok := os.LookupEnv("NO_COLOR"); ok {
+		return false
+	}
+	return s.pager != nil || s.IsStdoutTTY()
+}
+
+// pagerCommand returns the pager command from $GHCTL_PAGER, $PAGER or defaultPager.
+func pagerCommand() string {
+	if pager, ok := os.LookupEnv("GHCTL_PAGER"); ok {
+		return pager
+	}
+	if pager, ok := os.LookupEnv("PAGER"); ok {
+		return pager
+	}
+	return defaultPager
+}
+
+// StartPager replaces the Out with the writer which starts the pager process on the first write.
+// The pager starts lazily, so the spinners and progress written to the ErrOut, and the interactive
+// subprocesses run before the final output, do not fight with the pager on the terminal.
+// It does nothing if the Out is not the terminal, or the pager is empty or cat.
+func (s *IOStreams) StartPager() error {
+	if s.pager != nil || !s.IsStdoutTTY() {
+		return nil
+	}
+	args := strings.Fields(pagerCommand())
+	if len(args) == 0 || args[0] == "cat" {
+		return nil
+	}
+
+	s.pager = &pagerWriter{args: args, out: s.Out, errOut: s.ErrOut}
+	s.pagerOut = s.Out
+	s.Out = s.pager
+
+	return nil
+}
+
+// StopPager closes the pager stdin, waits for the pager exits and restores the Out.
+func (s *IOStreams) StopPager() {
+	if s.pager == nil {
+		return
+	}
+
+	s.pager.close()
+
+	s.Out = s.pagerOut
+	s.pager = nil
+	s.pagerOut = nil
+}
+
+// pagerWriter is the writer which starts the pager process on the first write, and writes to its stdin.
+type pagerWriter struct {
+	args   []string
+	out    io.Writer // stdout of the pager
+	errOut io.Writer
+
+	cmd *exec.Cmd
+	w   io.WriteCloser
+}
+
+// Write writes p to the pager, starting it on the first call.
+// If the pager can not start, it writes to the out directly.
+func (p *pagerWriter) Write(b []byte) (int, error) {
+	if p.w == nil {
+		if err := p.start(); err != nil {
+			fmt.Fprintf(p.errOut, "warning: %v\n", err)
+			p.w = nopWriteCloser{p.out}
+		}
+	}
+	return p.w.Write(b)
+}
+
+func (p *pagerWriter) start() error {
+	cmd := exec.Command(p.args[0], p.args[1:]...)
+	cmd.Stdout = p.out
+	cmd.Stderr = p.errOut
+	w, err := cmd.StdinPipe()
+	if err != nil {
+		return fmt.Errorf("could not get %s pager stdin: %w", p.args[0], err)
+	}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("could not start %s pager: %w", p.args[0], err)
+	}
+	p.cmd, p.w = cmd, w
+	return nil
+}
+
+// close closes the pager stdin and waits for the pager exits, if it is started.
+func (p *pagerWriter) close() {
+	if p.w == nil {
+		return
+	}
+	p.w.Close()
+	if p.cmd != nil {
+		_ = p.cmd.Wait() // the pager exit status is not our concern
+	}
+}
+
+// nopWriteCloser is the io.WriteCloser which Close does nothing.
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+// tokenFromEnv returns the GitHub token from the $GHCTL_TOKEN or $GITHUB_TOKEN environment variable.
+func tokenFromEnv() string {
 	token := os.Getenv("GHCTL_TOKEN")
 	if token == "" {
 		token = os.Getenv("GITHUB_TOKEN")
-		if token == "" {
-			return github.NewClient(nil)
-		}
+	}
+	return token
+}
+
+func newClient(ctx context.Context) *github.Client {
+	token := tokenFromEnv()
+	if token == "" {
+		return github.NewClient(nil)
 	}
 	source := oauth2.StaticTokenSource(&oauth2.Token{
 		AccessToken: token,
@@ -0,0 +1,390 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v38/github"
+	"github.com/spf13/cobra"
+
+	"github.com/zchee/ghctl/pkg/spin"
+)
+
+// completionCacheTTL is the lifetime of the cached dynamic completion candidates.
+const completionCacheTTL = 5 * time.Minute
+
+// completionTimeout is the maximum time to fetch the dynamic completion candidates.
+const completionTimeout = 5 * time.Second
+
+// completionCmd represents the completion command.
+var completionCmd = &cobra.Command{
+	Use:   "completion <bash|zsh|fish|powershell>",
+	Short: "Generate the shell completion script",
+	Long: `Generate the shell completion script for ghctl.
+
+To load completions:
+
+  bash:  source <(ghctl completion bash)
+  zsh:   ghctl completion zsh > "${fpath[1]}/_ghctl"
+  fish:  ghctl completion fish | source
+`,
+	ValidArgs:             []string{"bash", "zsh", "fish", "powershell"},
+	Args:                  cobra.ExactValidArgs(1),
+	DisableFlagsInUseLine: true,
+	Annotations:           noPagerAnnotation(),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runCompletion(cmd.OutOrStdout(), args[0])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(completionCmd)
+
+	starListCmd.ValidArgsFunction = completeOwners
+	repoListCmd.ValidArgsFunction = completeOwners
+	repoOpenCmd.ValidArgsFunction = completeRepositoryNames
+	repoDeleteCmd.ValidArgsFunction = completeRepositoryNames
+	repoCollaboratorCmd.ValidArgsFunction = completeRepositoryNames
+	repoAcceptInvitationCmd.ValidArgsFunction = completeInvitations
+	releaseCreateCmd.ValidArgsFunction = completeOwnerRepo
+	releaseDeleteCmd.ValidArgsFunction = completeOwnerRepoTag
+	prListCmd.ValidArgsFunction = completeOwnersOrRepositoryNames
+	prGetCmd.ValidArgsFunction = completeOwnerRepo
+}
+
+func runCompletion(w io.Writer, shell string) error {
+	switch shell {
+	case "bash":
+		return rootCmd.GenBashCompletionV2(w, true)
+	case "zsh":
+		return rootCmd.GenZshCompletion(w)
+	case "fish":
+		return rootCmd.GenFishCompletion(w, true)
+	case "powershell":
+		return rootCmd.GenPowerShellCompletionWithDesc(w)
+	default:
+		return fmt.Errorf("unsupported shell: %s", shell)
+	}
+}
+
+// cachedCompletion returns the cached candidates of key, or fetches and caches them by fn.
+// The cache is best-effort, and its errors are ignored since the completion can not report them.
+func cachedCompletion(key string, fn func(ctx context.Context, client *github.Client) ([]string, error)) ([]string, error) {
+	c, err := newCache(completionCacheTTL)
+	var candidates []string
+	if err == nil {
+		if ok, _ := c.Get(key, &candidates); ok {
+			return candidates, nil
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+
+	candidates, err = fn(ctx, newClient(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if c != nil {
+		_ = c.Set(key, candidates)
+	}
+
+	return candidates, nil
+}
+
+// completionOwners returns the authenticated user login and the owners of the user repositories.
+func completionOwners() ([]string, error) {
+	return cachedCompletion("completion:owners:"+tokenFromEnv(), func(ctx context.Context, client *github.Client) ([]string, error) {
+		login, err := authenticatedLogin(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		names, err := userRepositoryNames(ctx, client, &IOStreams{ErrOut: io.Discard})
+		if err != nil {
+			return nil, err
+		}
+
+		seen := map[string]bool{login: true}
+		owners := []string{login}
+		for _, name := range names {
+			owner := name[:strings.IndexByte(name, '/')]
+			if !seen[owner] {
+				seen[owner] = true
+				owners = append(owners, owner)
+			}
+		}
+		sort.Strings(owners)
+
+		return owners, nil
+	})
+}
+
+// completionRepositories returns the repository names of owner.
+func completionRepositories(owner string) ([]string, error) {
+	return cachedCompletion("completion:repos:"+owner, func(ctx context.Context, client *github.Client) ([]string, error) {
+		repos, err := listRepositories(ctx, client, owner, github.RepositoryListOptions{Type: "all"}, true, spin.NewProgress(io.Discard))
+		if err != nil {
+			return nil, err
+		}
+
+		names := make([]string, len(repos))
+		for i, repo := range repos {
+			names[i] = repo.GetName()
+		}
+		sort.Strings(names)
+
+		return names, nil
+	})
+}
+
+// completionTags returns the release tags of owner/repo.
+func completionTags(owner, repo string) ([]string, error) {
+	return cachedCompletion("completion:tags:"+owner+"/"+repo, func(ctx context.Context, client *github.Client) ([]string, error) {
+		opts := &github.ListOptions{PerPage: 100}
+		var tags []string
+		for {
+			releases, resp, err := client.Repositories.ListReleases(ctx, owner, repo, opts)
+			if err != nil {
+				return nil, IsRateLimitError(err)
+			}
+			for _, release := range releases {
+				tags = append(tags, release.GetTagName())
+			}
+			if resp.NextPage == 0 {
+				break
+			}
+			opts.Page = resp.NextPage
+		}
+
+		return tags, nil
+	})
+}
+
+// completionPullRequests returns the open pull request numbers of owner/repo with its title as the description.
+func completionPullRequests(owner, repo string) ([]string, error) {
+	return cachedCompletion("completion:pulls:"+owner+"/"+repo, func(ctx context.Context, client *github.Client) ([]string, error) {
+		opts := &github.PullRequestListOptions{
+			State:       "open",
+			ListOptions: github.ListOptions{PerPage: 100},
+		}
+		prs, _, err := client.PullRequests.List(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, IsRateLimitError(err)
+		}
+
+		candidates := make([]string, len(prs))
+		for i, pr := range prs {
+			candidates[i] = strconv.Itoa(pr.GetNumber()) + "\t" + pr.GetTitle()
+		}
+
+		return candidates, nil
+	})
+}
+
+// completionIssues returns the open issue numbers of owner/repo with its title as the description.
+func completionIssues(owner, repo string) ([]string, error) {
+	return cachedCompletion("completion:issues:"+owner+"/"+repo, func(ctx context.Context, client *github.Client) ([]string, error) {
+		opts := &github.IssueListByRepoOptions{
+			State:       "open",
+			ListOptions: github.ListOptions{PerPage: 100},
+		}
+		issues, _, err := client.Issues.ListByRepo(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, IsRateLimitError(err)
+		}
+
+		var candidates []string
+		for _, issue := range issues {
+			if issue.IsPullRequest() {
+				continue
+			}
+			candidates = append(candidates, strconv.Itoa(issue.GetNumber())+"\t"+issue.GetTitle())
+		}
+
+		return candidates, nil
+	})
+}
+
+// filterPrefix returns the candidates which has the prefix.
+func filterPrefix(candidates []string, prefix string) []string {
+	var res []string
+	for _, c := range candidates {
+		if strings.HasPrefix(c, prefix) {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+// completeOwners completes the first argument as the owner name.
+func completeOwners(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	owners, err := completionOwners()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	return filterPrefix(owners, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeRepository completes toComplete as the owner/repo name.
+func completeRepository(toComplete string) ([]string, cobra.ShellCompDirective) {
+	i := strings.IndexByte(toComplete, '/')
+	if i < 0 {
+		owners, err := completionOwners()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		owners = filterPrefix(owners, toComplete)
+		for i := range owners {
+			owners[i] += "/"
+		}
+		return owners, cobra.ShellCompDirectiveNoSpace | cobra.ShellCompDirectiveNoFileComp
+	}
+
+	owner := toComplete[:i]
+	repos, err := completionRepositories(owner)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	names := make([]string, len(repos))
+	for i, repo := range repos {
+		names[i] = owner + "/" + repo
+	}
+
+	return filterPrefix(names, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeRepositoryNames completes the first argument as the owner/repo name.
+func completeRepositoryNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeRepository(toComplete)
+}
+
+// completeOwnersOrRepositoryNames completes the all arguments as the owner or owner/repo name.
+func completeOwnersOrRepositoryNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	candidates, directive := completeRepository(toComplete)
+	if !strings.Contains(toComplete, "/") {
+		// the owner itself is also the valid argument
+		for i := range candidates {
+			candidates = append(candidates, strings.TrimSuffix(candidates[i], "/"))
+		}
+	}
+	return candidates, directive
+}
+
+// completeOwnerRepo completes the <owner> <repo> arguments.
+func completeOwnerRepo(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	switch len(args) {
+	case 0:
+		return completeOwners(cmd, args, toComplete)
+	case 1:
+		repos, err := completionRepositories(args[0])
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		return filterPrefix(repos, toComplete), cobra.ShellCompDirectiveNoFileComp
+	default:
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completeOwnerRepoTag completes the <owner> <repo> <tag> arguments.
+func completeOwnerRepoTag(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) != 2 {
+		return completeOwnerRepo(cmd, args, toComplete)
+	}
+	tags, err := completionTags(args[0], args[1])
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	return filterPrefix(tags, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeInvitations completes the first argument as the repository name of the pending invitations.
+// The invitations are not cached since they are gone once accepted.
+func completeInvitations(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+
+	client := newClient(ctx)
+	if flags.acceptUserToken != "" {
+		client = newClientFromToken(ctx, flags.acceptUserToken)
+	}
+	invitations, _, err := client.Users.ListInvitations(ctx, &github.ListOptions{PerPage: 100})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	names := make([]string, len(invitations))
+	for i, inv := range invitations {
+		names[i] = inv.GetRepo().GetFullName() + "\tinvited by " + inv.GetInviter().GetLogin()
+	}
+
+	return filterPrefix(names, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeRepositoryPullRequest completes the <owner/repo> <number> arguments.
+func completeRepositoryPullRequest(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	switch len(args) {
+	case 0:
+		return completeRepository(toComplete)
+	case 1:
+		owner, repo, err := splitOwnerRepo(args[0])
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		prs, err := completionPullRequests(owner, repo)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		return filterPrefix(prs, toComplete), cobra.ShellCompDirectiveNoFileComp
+	default:
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completeRepositoryIssue completes the <owner/repo> <number> arguments of the issue.
+func completeRepositoryIssue(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	switch len(args) {
+	case 0:
+		return completeRepository(toComplete)
+	case 1:
+		owner, repo, err := splitOwnerRepo(args[0])
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		issues, err := completionIssues(owner, repo)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		return filterPrefix(issues, toComplete), cobra.ShellCompDirectiveNoFileComp
+	default:
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// splitOwnerRepo splits the owner/repo name.
+func splitOwnerRepo(fullname string) (owner, repo string, err error) {
+	ss := strings.Split(fullname, "/")
+	if len(ss) != 2 || ss[0] == "" || ss[1] == "" {
+		return "", "", fmt.Errorf("invalid repository name %q: must be <owner/repository>", fullname)
+	}
+	return ss[0], ss[1], nil
+}
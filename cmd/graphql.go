@@ -0,0 +1,58 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v38/github"
+)
+
+// graphQLRequest represents the request body of the GitHub GraphQL API.
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+// graphQLResponse represents the response body of the GitHub GraphQL API.
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// doGraphQL runs the GraphQL query with variables, and decodes the data of the response into v.
+// v may be nil if the caller does not need the data.
+func doGraphQL(ctx context.Context, client *github.Client, query string, variables map[string]interface{}, v interface{}) error {
+	req, err := client.NewRequest("POST", "graphql", &graphQLRequest{
+		Query:     query,
+		Variables: variables,
+	})
+	if err != nil {
+		return fmt.Errorf("could not create GraphQL request: %w", err)
+	}
+
+	var resp graphQLResponse
+	if _, err := client.Do(ctx, req, &resp); err != nil {
+		return fmt.Errorf("could not request GraphQL API: %w", IsRateLimitError(err))
+	}
+	if len(resp.Errors) > 0 {
+		msgs := make([]string, len(resp.Errors))
+		for i, e := range resp.Errors {
+			msgs[i] = e.Message
+		}
+		return errors.New(strings.Join(msgs, "; "))
+	}
+
+	if v == nil || len(resp.Data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(resp.Data, v)
+}
@@ -0,0 +1,251 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/go-github/v38/github"
+	"github.com/spf13/cobra"
+)
+
+// issueCmd represents the issue command
+var issueCmd = &cobra.Command{
+	Use:   "issue",
+	Short: "manage the issue",
+}
+
+type issueListCmd struct {
+	ioStreams *IOStreams
+
+	state     string
+	labels    []string
+	assignee  string
+	author    string
+	milestone string
+	limit     int
+	output    string
+}
+
+func init() {
+	rootCmd.AddCommand(issueCmd)
+	issueCmd.AddCommand(newCmdIssueList())
+}
+
+func newCmdIssueList() *cobra.Command {
+	c := &issueListCmd{
+		ioStreams: defaultIOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list <owner/repo>",
+		Short: "Lists the issues of the repository",
+		Long: `Lists the issues of the repository. The pull requests are not listed.
+
+The --assignee and --milestone flags accept "*" for any and "none" for no assignee or milestone.
+The --milestone flag accepts the milestone number or title.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkArgs(cmd, args, 1, exactArgs, "<owner/repo>"); err != nil {
+				return err
+			}
+			owner, repo, err := splitOwnerRepo(args[0])
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			return c.runList(ctx, owner, repo)
+		},
+		ValidArgsFunction: completeRepositoryNames,
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&c.state, "state", "open", "state of the issues. [open, closed, all]")
+	f.StringSliceVarP(&c.labels, "label", "l", nil, "list the issues which have the all labels")
+	f.StringVarP(&c.assignee, "assignee", "a", "", "list the issues assigned to the user")
+	f.StringVarP(&c.author, "author", "A", "", "list the issues created by the user")
+	f.StringVarP(&c.milestone, "milestone", "m", "", "list the issues of the milestone")
+	f.IntVarP(&c.limit, "limit", "L", 30, "maximum number of the issues")
+	f.StringVarP(&c.output, "output", "o", string(outputText), "output format. [text, json]")
+
+	return cmd
+}
+
+// issueListItem represents the issue of the issue list command.
+type issueListItem struct {
+	Number    int       `json:"number"`
+	Title     string    `json:"title"`
+	URL       string    `json:"url"`
+	State     string    `json:"state"`
+	Author    string    `json:"author"`
+	Labels    []string  `json:"labels"`
+	Assignees []string  `json:"assignees"`
+	Milestone string    `json:"milestone,omitempty"`
+	Comments  int       `json:"comments"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func newIssueListItem(issue *github.Issue) issueListItem {
+	assignees := make([]string, len(issue.Assignees))
+	for i, user := range issue.Assignees {
+		assignees[i] = user.GetLogin()
+	}
+	return issueListItem{
+		Number:    issue.GetNumber(),
+		Title:     issue.GetTitle(),
+		URL:       issue.GetHTMLURL(),
+		State:     issue.GetState(),
+		Author:    issue.GetUser().GetLogin(),
+		Labels:    issueLabelNames(issue),
+		Assignees: assignees,
+		Milestone: issue.GetMilestone().GetTitle(),
+		Comments:  issue.GetComments(),
+		CreatedAt: issue.GetCreatedAt(),
+		UpdatedAt: issue.GetUpdatedAt(),
+	}
+}
+
+func (c *issueListCmd) runList(ctx context.Context, owner, repo string) error {
+	format, err := parseOutputFormat(c.output, outputText, outputJSON)
+	if err != nil {
+		return err
+	}
+	switch c.state {
+	case "open", "closed", "all":
+	default:
+		return fmt.Errorf("invalid state %q: must be one of [open, closed, all]", c.state)
+	}
+	if c.limit <= 0 {
+		return errors.New("--limit flag must be positive")
+	}
+
+	client := newClient(ctx)
+
+	opts := &github.IssueListByRepoOptions{
+		State:       c.state,
+		Labels:      c.labels,
+		Assignee:    c.assignee,
+		Creator:     c.author,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	switch c.milestone {
+	case "", "*", "none":
+		opts.Milestone = c.milestone
+	default:
+		number, err := findMilestone(ctx, client, owner, repo, c.milestone)
+		if err != nil {
+			return err
+		}
+		opts.Milestone = strconv.Itoa(number)
+	}
+
+	issues, err := listIssuesLimit(ctx, client, owner, repo, opts, c.limit)
+	if err != nil {
+		return err
+	}
+
+	items := make([]issueListItem, len(issues))
+	for i, issue := range issues {
+		items[i] = newIssueListItem(issue)
+	}
+
+	if format == outputJSON {
+		return writeJSON(c.ioStreams.Out, items)
+	}
+	if len(items) == 0 {
+		fmt.Fprintf(c.ioStreams.ErrOut, "no %s issues found in %s/%s\n", c.state, owner, repo)
+		return nil
+	}
+	return writeIssueList(c.ioStreams.Out, items)
+}
+
+// listIssuesLimit lists up to limit issues which match opts from the owner/repo repository.
+// The pull requests, which the issues API also returns, are skipped. The zero limit lists the all issues.
+func listIssuesLimit(ctx context.Context, client *github.Client, owner, repo string, opts *github.IssueListByRepoOptions, limit int) ([]*github.Issue, error) {
+	var res []*github.Issue
+	for {
+		issues, resp, err := client.Issues.ListByRepo(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, fmt.Errorf("could not list issues of %s/%s: %w", owner, repo, IsRateLimitError(err))
+		}
+		for _, issue := range issues {
+			if issue.IsPullRequest() {
+				continue
+			}
+			res = append(res, issue)
+			if len(res) == limit {
+				return res, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return res, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// findMilestone returns the number of the milestone of owner/repo which the number or title is name.
+func findMilestone(ctx context.Context, client *github.Client, owner, repo, name string) (int, error) {
+	if number, err := strconv.Atoi(name); err == nil && number > 0 {
+		return number, nil
+	}
+
+	opts := &github.MilestoneListOptions{
+		State:       "all",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	for {
+		milestones, resp, err := client.Issues.ListMilestones(ctx, owner, repo, opts)
+		if err != nil {
+			return 0, fmt.Errorf("could not list milestones of %s/%s: %w", owner, repo, IsRateLimitError(err))
+		}
+		for _, milestone := range milestones {
+			if strings.EqualFold(milestone.GetTitle(), name) {
+				return milestone.GetNumber(), nil
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return 0, fmt.Errorf("milestone %q not found in %s/%s", name, owner, repo)
+}
+
+// issueLabelNames returns the label names of issue.
+func issueLabelNames(issue *github.Issue) []string {
+	labels := make([]string, len(issue.Labels))
+	for i, label := range issue.Labels {
+		labels[i] = label.GetName()
+	}
+	return labels
+}
+
+func writeIssueList(w io.Writer, items []issueListItem) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "NUMBER\tSTATE\tAUTHOR\tLABELS\tUPDATED\tTITLE")
+	for _, item := range items {
+		labels := "-"
+		if len(item.Labels) > 0 {
+			labels = strings.Join(item.Labels, ", ")
+		}
+		fmt.Fprintf(tw, "#%d\t%s\t%s\t%s\t%s\t%s\n", item.Number, item.State, item.Author, labels, item.UpdatedAt.Format("2006-01-02"), item.Title)
+	}
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("could not flush tabwriter: %w", err)
+	}
+	return nil
+}
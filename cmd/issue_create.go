@@ -0,0 +1,118 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v38/github"
+	"github.com/spf13/cobra"
+)
+
+type issueCreateCmd struct {
+	ioStreams *IOStreams
+
+	title     string
+	body      string
+	bodyFile  string
+	editor    bool
+	labels    []string
+	assignees []string
+	milestone string
+}
+
+func init() {
+	issueCmd.AddCommand(newCmdIssueCreate())
+}
+
+func newCmdIssueCreate() *cobra.Command {
+	c := &issueCreateCmd{
+		ioStreams: defaultIOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <owner/repo>",
+		Short: "Creates the issue",
+		Long: `Creates the issue.
+
+If none of --body, --body-file and --editor flags is set, the body is written in the editor in the interactive mode.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkArgs(cmd, args, 1, exactArgs, "<owner/repo>"); err != nil {
+				return err
+			}
+			owner, repo, err := splitOwnerRepo(args[0])
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			return c.runCreate(ctx, owner, repo)
+		},
+		Annotations:       noPagerAnnotation(),
+		ValidArgsFunction: completeRepositoryNames,
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(&c.title, "title", "t", "", "title of the issue (required)")
+	f.StringVarP(&c.body, "body", "b", "", "body of the issue")
+	f.StringVarP(&c.bodyFile, "body-file", "F", "", "read the body of the issue from the file. \"-\" reads from stdin")
+	f.BoolVarP(&c.editor, "editor", "e", false, "write the body of the issue in the editor")
+	f.StringSliceVarP(&c.labels, "label", "l", nil, "labels of the issue")
+	f.StringSliceVarP(&c.assignees, "assignee", "a", nil, "assignees of the issue")
+	f.StringVarP(&c.milestone, "milestone", "m", "", "milestone number or title of the issue")
+
+	return cmd
+}
+
+func (c *issueCreateCmd) runCreate(ctx context.Context, owner, repo string) error {
+	if strings.TrimSpace(c.title) == "" {
+		return errors.New("--title flag must be not empty")
+	}
+
+	body, ok, err := bodyFromFlags(ctx, c.ioStreams.In, c.body, c.bodyFile, c.editor, "")
+	if !ok && err == nil && canPrompt(c.ioStreams) {
+		body, err = editBody(ctx, "")
+	}
+	if errors.Is(err, errEditAborted) {
+		return fmt.Errorf("issue is not created: %w", err)
+	}
+	if err != nil {
+		return err
+	}
+
+	client := newClient(ctx)
+
+	req := &github.IssueRequest{
+		Title: github.String(c.title),
+		Body:  github.String(body),
+	}
+	if len(c.labels) > 0 {
+		req.Labels = &c.labels
+	}
+	if len(c.assignees) > 0 {
+		req.Assignees = &c.assignees
+	}
+	if c.milestone != "" {
+		number, err := findMilestone(ctx, client, owner, repo, c.milestone)
+		if err != nil {
+			return err
+		}
+		req.Milestone = github.Int(number)
+	}
+
+	issue, _, err := client.Issues.Create(ctx, owner, repo, req)
+	if err != nil {
+		return fmt.Errorf("could not create issue in %s/%s: %w", owner, repo, IsRateLimitError(err))
+	}
+
+	fmt.Fprintln(c.ioStreams.Out, issue.GetHTMLURL())
+
+	return nil
+}
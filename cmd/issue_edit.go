@@ -0,0 +1,173 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v38/github"
+	"github.com/spf13/cobra"
+)
+
+type issueEditCmd struct {
+	ioStreams *IOStreams
+
+	title           string
+	body            string
+	bodyFile        string
+	editor          bool
+	addLabels       []string
+	removeLabels    []string
+	addAssignees    []string
+	removeAssignees []string
+	milestone       string
+}
+
+func init() {
+	issueCmd.AddCommand(newCmdIssueEdit())
+}
+
+func newCmdIssueEdit() *cobra.Command {
+	c := &issueEditCmd{
+		ioStreams: defaultIOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "edit <owner/repo> <number>",
+		Short: "Edits the title, body, labels, assignees and milestone of the issue",
+		Long: `Edits the title, body, labels, assignees and milestone of the issue.
+
+The --editor flag opens the current body of the issue. The --milestone flag accepts "none" to remove the milestone.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkArgs(cmd, args, 2, exactArgs, "<owner/repo> <number>"); err != nil {
+				return err
+			}
+			owner, repo, number, err := parseRepositoryNumber(args)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			return c.runEdit(ctx, owner, repo, number)
+		},
+		Annotations:       noPagerAnnotation(),
+		ValidArgsFunction: completeRepositoryIssue,
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(&c.title, "title", "t", "", "new title of the issue")
+	f.StringVarP(&c.body, "body", "b", "", "new body of the issue")
+	f.StringVarP(&c.bodyFile, "body-file", "F", "", "read the new body of the issue from the file. \"-\" reads from stdin")
+	f.BoolVarP(&c.editor, "editor", "e", false, "edit the body of the issue in the editor")
+	f.StringSliceVar(&c.addLabels, "add-label", nil, "add the labels to the issue")
+	f.StringSliceVar(&c.removeLabels, "remove-label", nil, "remove the labels from the issue")
+	f.StringSliceVar(&c.addAssignees, "add-assignee", nil, "add the assignees to the issue")
+	f.StringSliceVar(&c.removeAssignees, "remove-assignee", nil, "remove the assignees from the issue")
+	f.StringVarP(&c.milestone, "milestone", "m", "", "milestone number or title of the issue")
+
+	return cmd
+}
+
+func (c *issueEditCmd) runEdit(ctx context.Context, owner, repo string, number int) error {
+	if c.title == "" && c.body == "" && c.bodyFile == "" && !c.editor &&
+		len(c.addLabels) == 0 && len(c.removeLabels) == 0 && len(c.addAssignees) == 0 && len(c.removeAssignees) == 0 && c.milestone == "" {
+		return errors.New("specify any of --title, --body, --body-file, --editor, --add-label, --remove-label, --add-assignee, --remove-assignee or --milestone flags")
+	}
+
+	client := newClient(ctx)
+
+	issue, _, err := client.Issues.Get(ctx, owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("could not get %s/%s#%d issue: %w", owner, repo, number, IsRateLimitError(err))
+	}
+	if issue.IsPullRequest() {
+		return fmt.Errorf("%s/%s#%d is the pull request, not the issue", owner, repo, number)
+	}
+
+	req := &github.IssueRequest{}
+	if c.title != "" {
+		req.Title = github.String(c.title)
+	}
+	body, ok, err := bodyFromFlags(ctx, c.ioStreams.In, c.body, c.bodyFile, c.editor, issue.GetBody())
+	if errors.Is(err, errEditAborted) {
+		return fmt.Errorf("%s is not edited: %w", issue.GetHTMLURL(), err)
+	}
+	if err != nil {
+		return err
+	}
+	if ok {
+		req.Body = github.String(body)
+	}
+	if len(c.addLabels) > 0 || len(c.removeLabels) > 0 {
+		labels := editNames(issueLabelNames(issue), c.addLabels, c.removeLabels)
+		req.Labels = &labels
+	}
+	if len(c.addAssignees) > 0 || len(c.removeAssignees) > 0 {
+		current := make([]string, len(issue.Assignees))
+		for i, user := range issue.Assignees {
+			current[i] = user.GetLogin()
+		}
+		assignees := editNames(current, c.addAssignees, c.removeAssignees)
+		req.Assignees = &assignees
+	}
+	if c.milestone != "" && c.milestone != "none" {
+		milestone, err := findMilestone(ctx, client, owner, repo, c.milestone)
+		if err != nil {
+			return err
+		}
+		req.Milestone = github.Int(milestone)
+	}
+
+	if *req != (github.IssueRequest{}) {
+		if _, _, err := client.Issues.Edit(ctx, owner, repo, number, req); err != nil {
+			return fmt.Errorf("could not edit %s: %w", issue.GetHTMLURL(), IsRateLimitError(err))
+		}
+	}
+	if c.milestone == "none" {
+		if err := removeIssueMilestone(ctx, client, owner, repo, number); err != nil {
+			return fmt.Errorf("could not remove the milestone of %s: %w", issue.GetHTMLURL(), err)
+		}
+	}
+
+	fmt.Fprintln(c.ioStreams.Out, issue.GetHTMLURL())
+
+	return nil
+}
+
+// editNames returns current with add appended and remove removed, case-insensitively and without duplicates.
+func editNames(current, add, remove []string) []string {
+	seen := make(map[string]bool)
+	for _, name := range remove {
+		seen[strings.ToLower(name)] = true
+	}
+	names := []string{} // the empty list removes the all names
+	for _, name := range append(current, add...) {
+		if key := strings.ToLower(name); !seen[key] {
+			seen[key] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// removeIssueMilestone removes the milestone of the issue.
+// IssueRequest can not send the null milestone because of omitempty, so it sends the raw request.
+func removeIssueMilestone(ctx context.Context, client *github.Client, owner, repo string, number int) error {
+	u := fmt.Sprintf("repos/%s/%s/issues/%d", owner, repo, number)
+	req, err := client.NewRequest(http.MethodPatch, u, map[string]interface{}{"milestone": nil})
+	if err != nil {
+		return err
+	}
+	if _, err := client.Do(ctx, req, nil); err != nil {
+		return IsRateLimitError(err)
+	}
+	return nil
+}
@@ -0,0 +1,518 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/go-github/v38/github"
+	"github.com/spf13/cobra"
+
+	"github.com/zchee/ghctl/pkg/spin"
+)
+
+type issueMigrateCmd struct {
+	ioStreams *IOStreams
+
+	state       string
+	labels      []string
+	closeSource bool
+	mappingFile string
+	dryRun      bool
+}
+
+func init() {
+	issueCmd.AddCommand(newCmdIssueMigrate())
+}
+
+func newCmdIssueMigrate() *cobra.Command {
+	c := &issueMigrateCmd{
+		ioStreams: defaultIOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "migrate <src owner/repo> <dst owner/repo>",
+		Short: "Copies the issues with the comments, labels and milestones to the other repository",
+		Long: `Copies the issues with the comments, labels and milestones to the other repository.
+
+The copied issue has the back-reference to the source issue, and the comments are copied with the original authors and dates.
+The missing labels and milestones are created in the destination repository. The closed source issue is closed after copied.
+With --close-source, the source issue is commented with the link to the copied issue and closed.
+
+The issues and comments are created about one per second, and the request which hits the secondary rate limit
+is retried after the Retry-After time. The progress is recorded to the mapping file after each step,
+so the interrupted run continues from where it stopped by running the same command again.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkArgs(cmd, args, 2, exactArgs, "<src owner/repo> <dst owner/repo>"); err != nil {
+				return err
+			}
+			srcOwner, srcRepo, err := splitOwnerRepo(args[0])
+			if err != nil {
+				return err
+			}
+			dstOwner, dstRepo, err := splitOwnerRepo(args[1])
+			if err != nil {
+				return err
+			}
+			if strings.EqualFold(args[0], args[1]) {
+				return errors.New("source and destination repositories must be different")
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			return c.runMigrate(ctx, srcOwner, srcRepo, dstOwner, dstRepo)
+		},
+		Annotations: noPagerAnnotation(),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 1 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return completeRepository(toComplete)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&c.state, "state", "open", "state of the issues to migrate. [open, closed, all]")
+	f.StringSliceVarP(&c.labels, "label", "l", nil, "migrate the issues which have the all labels")
+	f.BoolVar(&c.closeSource, "close-source", false, "comment the link to the copied issue on the source issue and close it")
+	f.StringVar(&c.mappingFile, "mapping", "", "path of the mapping file of the migrated issues (default: ghctl-migrate-<src owner>-<src repo>-<dst owner>-<dst repo>.json)")
+	f.BoolVar(&c.dryRun, "dry-run", false, "list the issues to migrate without copying")
+
+	return cmd
+}
+
+// migrateMapping represents the mapping file of the issue migration.
+type migrateMapping struct {
+	Source      string                   `json:"source"`
+	Destination string                   `json:"destination"`
+	Issues      map[string]*migrateIssue `json:"issues"` // keyed by the source issue number
+}
+
+// migrateIssue represents the progress of the migration of an issue.
+type migrateIssue struct {
+	Number          int       `json:"number"`   // number of the copied issue, or 0 while creating it
+	Started         time.Time `json:"started"`  // time before creating the copied issue
+	Comments        int       `json:"comments"` // number of the copied comments
+	Done            bool      `json:"done"`     // the comments are copied and the state is synced
+	SourceCommented bool      `json:"source_commented,omitempty"`
+	SourceClosed    bool      `json:"source_closed,omitempty"`
+}
+
+// loadMigrateMapping loads the mapping file, or returns the empty mapping if it does not exist.
+func loadMigrateMapping(fname, src, dst string) (*migrateMapping, error) {
+	mapping := &migrateMapping{
+		Source:      src,
+		Destination: dst,
+		Issues:      make(map[string]*migrateIssue),
+	}
+	data, err := os.ReadFile(fname)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return mapping, nil
+		}
+		return nil, fmt.Errorf("could not read mapping file: %w", err)
+	}
+	if err := json.Unmarshal(data, mapping); err != nil {
+		return nil, fmt.Errorf("could not parse mapping file %s: %w", fname, err)
+	}
+	if !strings.EqualFold(mapping.Source, src) || !strings.EqualFold(mapping.Destination, dst) {
+		return nil, fmt.Errorf("mapping file %s is for %s to %s, not %s to %s", fname, mapping.Source, mapping.Destination, src, dst)
+	}
+	if mapping.Issues == nil {
+		mapping.Issues = make(map[string]*migrateIssue)
+	}
+	return mapping, nil
+}
+
+// save writes the mapping to fname. It writes to the temporary file and renames it, so the interruption does not break the file.
+func (m *migrateMapping) save(fname string) error {
+	data, err := json.MarshalIndent(m, "", "\t")
+	if err != nil {
+		return fmt.Errorf("could not marshal mapping: %w", err)
+	}
+	tmp := fname + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("could not write mapping file: %w", err)
+	}
+	if err := os.Rename(tmp, fname); err != nil {
+		return fmt.Errorf("could not write mapping file: %w", err)
+	}
+	return nil
+}
+
+// issueMigrator copies the issues from the source to the destination repository.
+type issueMigrator struct {
+	client      *github.Client
+	srcOwner    string
+	srcRepo     string
+	dstOwner    string
+	dstRepo     string
+	closeSource bool
+
+	mapping     *migrateMapping
+	mappingFile string
+
+	labels     map[string]bool // lower-cased label names of the destination
+	milestones map[string]int  // milestone numbers of the destination keyed by the title
+
+	// pacer paces the write requests, since the migration creates many issues and comments back to back
+	pacer writePacer
+}
+
+func (c *issueMigrateCmd) runMigrate(ctx context.Context, srcOwner, srcRepo, dstOwner, dstRepo string) error {
+	switch c.state {
+	case "open", "closed", "all":
+	default:
+		return fmt.Errorf("invalid state %q: must be one of [open, closed, all]", c.state)
+	}
+
+	src, dst := srcOwner+"/"+srcRepo, dstOwner+"/"+dstRepo
+	if c.mappingFile == "" {
+		c.mappingFile = fmt.Sprintf("ghctl-migrate-%s-%s-%s-%s.json", srcOwner, srcRepo, dstOwner, dstRepo)
+	}
+	mapping, err := loadMigrateMapping(c.mappingFile, src, dst)
+	if err != nil {
+		return err
+	}
+
+	client := newClient(ctx)
+
+	progress := spin.NewProgress(c.ioStreams.ErrOut)
+	progress.AddTask("fetching issues", 0)
+	progress.Start()
+	issues, err := listIssuesLimit(ctx, client, srcOwner, srcRepo, &github.IssueListByRepoOptions{
+		State:       c.state,
+		Labels:      c.labels,
+		Sort:        "created",
+		Direction:   "asc",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}, 0)
+	progress.Stop()
+	if err != nil {
+		return err
+	}
+
+	var pending []*github.Issue
+	for _, issue := range issues {
+		if m, ok := mapping.Issues[strconv.Itoa(issue.GetNumber())]; ok && m.Done && (!c.closeSource || m.SourceClosed) {
+			continue
+		}
+		pending = append(pending, issue)
+	}
+	if len(pending) == 0 {
+		fmt.Fprintf(c.ioStreams.ErrOut, "no issues to migrate from %s to %s\n", src, dst)
+		return nil
+	}
+
+	if c.dryRun {
+		tw := tabwriter.NewWriter(c.ioStreams.Out, 0, 8, 2, ' ', 0)
+		for _, issue := range pending {
+			fmt.Fprintf(tw, "%s#%d\t%s\t%s\n", src, issue.GetNumber(), issue.GetState(), issue.GetTitle())
+		}
+		if err := tw.Flush(); err != nil {
+			return fmt.Errorf("could not flush tabwriter: %w", err)
+		}
+		fmt.Fprintf(c.ioStreams.ErrOut, "\ndry-run: %d issues to migrate\n", len(pending))
+		return nil
+	}
+
+	m := &issueMigrator{
+		client:      client,
+		srcOwner:    srcOwner,
+		srcRepo:     srcRepo,
+		dstOwner:    dstOwner,
+		dstRepo:     dstRepo,
+		closeSource: c.closeSource,
+		mapping:     mapping,
+		mappingFile: c.mappingFile,
+	}
+	if err := m.loadDestination(ctx); err != nil {
+		return err
+	}
+
+	// migrate sequentially to keep the order of the issue numbers, and to make the mapping file resumable
+	progress = spin.NewProgress(c.ioStreams.ErrOut)
+	task := progress.AddTask("migrating issues", len(pending))
+	progress.Start()
+	for _, issue := range pending {
+		if err := m.migrate(ctx, issue); err != nil {
+			progress.Stop()
+			return fmt.Errorf("could not migrate %s#%d: %w\nrun the same command again to continue the migration", src, issue.GetNumber(), err)
+		}
+		task.Increment()
+	}
+	progress.Stop()
+
+	for _, issue := range pending {
+		fmt.Fprintf(c.ioStreams.Out, "✓ %s#%d -> %s#%d\n", src, issue.GetNumber(), dst, mapping.Issues[strconv.Itoa(issue.GetNumber())].Number)
+	}
+
+	return nil
+}
+
+// loadDestination loads the labels and milestones of the destination repository.
+func (m *issueMigrator) loadDestination(ctx context.Context) error {
+	m.labels = make(map[string]bool)
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		labels, resp, err := m.client.Issues.ListLabels(ctx, m.dstOwner, m.dstRepo, opts)
+		if err != nil {
+			return fmt.Errorf("could not list labels of %s/%s: %w", m.dstOwner, m.dstRepo, IsRateLimitError(err))
+		}
+		for _, label := range labels {
+			m.labels[strings.ToLower(label.GetName())] = true
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	m.milestones = make(map[string]int)
+	mopts := &github.MilestoneListOptions{State: "all", ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		milestones, resp, err := m.client.Issues.ListMilestones(ctx, m.dstOwner, m.dstRepo, mopts)
+		if err != nil {
+			return fmt.Errorf("could not list milestones of %s/%s: %w", m.dstOwner, m.dstRepo, IsRateLimitError(err))
+		}
+		for _, milestone := range milestones {
+			m.milestones[milestone.GetTitle()] = milestone.GetNumber()
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		mopts.Page = resp.NextPage
+	}
+
+	return nil
+}
+
+// migrate copies issue to the destination, continuing from the progress in the mapping.
+func (m *issueMigrator) migrate(ctx context.Context, issue *github.Issue) error {
+	key := strconv.Itoa(issue.GetNumber())
+	progress, ok := m.mapping.Issues[key]
+	if !ok {
+		// record the intent before creating, so the rerun can find the issue created by the interrupted run
+		progress = &migrateIssue{Started: time.Now()}
+		m.mapping.Issues[key] = progress
+		if err := m.mapping.save(m.mappingFile); err != nil {
+			return err
+		}
+	}
+	if progress.Number == 0 {
+		number, err := m.findCopiedIssue(ctx, issue, progress.Started)
+		if err != nil {
+			return err
+		}
+		if number == 0 {
+			if number, err = m.createIssue(ctx, issue); err != nil {
+				return err
+			}
+		}
+		progress.Number = number
+		if err := m.mapping.save(m.mappingFile); err != nil {
+			return err
+		}
+	}
+
+	if !progress.Done {
+		if err := m.copyComments(ctx, issue, progress); err != nil {
+			return err
+		}
+		if issue.GetState() == "closed" {
+			if err := m.pacer.do(ctx, func() error {
+				_, _, err := m.client.Issues.Edit(ctx, m.dstOwner, m.dstRepo, progress.Number, &github.IssueRequest{State: github.String("closed")})
+				return err
+			}); err != nil {
+				return fmt.Errorf("could not close %s/%s#%d: %w", m.dstOwner, m.dstRepo, progress.Number, IsRateLimitError(err))
+			}
+		}
+		progress.Done = true
+		if err := m.mapping.save(m.mappingFile); err != nil {
+			return err
+		}
+	}
+
+	if m.closeSource && !progress.SourceClosed {
+		if err := m.closeSourceIssue(ctx, issue, progress); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// migratedFrom returns the back-reference to issue at the beginning of the body of the copied issue.
+func migratedFrom(issue *github.Issue) string {
+	return fmt.Sprintf("_Migrated from %s, ", issue.GetHTMLURL())
+}
+
+// findCopiedIssue returns the number of the destination issue which has the back-reference to issue,
+// looking up the issues updated since started. It returns 0 if no issue is found.
+func (m *issueMigrator) findCopiedIssue(ctx context.Context, issue *github.Issue, started time.Time) (int, error) {
+	if started.IsZero() {
+		return 0, nil
+	}
+	issues, err := listIssuesLimit(ctx, m.client, m.dstOwner, m.dstRepo, &github.IssueListByRepoOptions{
+		State:       "all",
+		Since:       started,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}, 0)
+	if err != nil {
+		return 0, err
+	}
+	for _, copied := range issues {
+		if strings.HasPrefix(copied.GetBody(), migratedFrom(issue)) {
+			return copied.GetNumber(), nil
+		}
+	}
+	return 0, nil
+}
+
+// createIssue creates the copy of issue in the destination with the labels and milestone, and returns the number.
+func (m *issueMigrator) createIssue(ctx context.Context, issue *github.Issue) (int, error) {
+	labels := make([]string, 0, len(issue.Labels))
+	for _, label := range issue.Labels {
+		if err := m.ensureLabel(ctx, label); err != nil {
+			return 0, err
+		}
+		labels = append(labels, label.GetName())
+	}
+
+	body := fmt.Sprintf("%soriginally opened by **%s** on %s._\n\n%s",
+		migratedFrom(issue), issue.GetUser().GetLogin(), issue.GetCreatedAt().Format("2006-01-02"), issue.GetBody())
+	req := &github.IssueRequest{
+		Title:  github.String(issue.GetTitle()),
+		Body:   github.String(body),
+		Labels: &labels,
+	}
+	if issue.Milestone != nil {
+		number, err := m.ensureMilestone(ctx, issue.Milestone)
+		if err != nil {
+			return 0, err
+		}
+		req.Milestone = github.Int(number)
+	}
+
+	var created *github.Issue
+	err := m.pacer.do(ctx, func() (err error) {
+		created, _, err = m.client.Issues.Create(ctx, m.dstOwner, m.dstRepo, req)
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("could not create issue in %s/%s: %w", m.dstOwner, m.dstRepo, IsRateLimitError(err))
+	}
+	return created.GetNumber(), nil
+}
+
+// ensureLabel creates label in the destination if it does not exist.
+func (m *issueMigrator) ensureLabel(ctx context.Context, label *github.Label) error {
+	name := strings.ToLower(label.GetName())
+	if m.labels[name] {
+		return nil
+	}
+	err := m.pacer.do(ctx, func() error {
+		_, _, err := m.client.Issues.CreateLabel(ctx, m.dstOwner, m.dstRepo, &github.Label{
+			Name:        label.Name,
+			Color:       label.Color,
+			Description: label.Description,
+		})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("could not create %s label in %s/%s: %w", label.GetName(), m.dstOwner, m.dstRepo, IsRateLimitError(err))
+	}
+	m.labels[name] = true
+	return nil
+}
+
+// ensureMilestone returns the number of the destination milestone which has the same title as milestone, creating it if it does not exist.
+func (m *issueMigrator) ensureMilestone(ctx context.Context, milestone *github.Milestone) (int, error) {
+	if number, ok := m.milestones[milestone.GetTitle()]; ok {
+		return number, nil
+	}
+	var created *github.Milestone
+	err := m.pacer.do(ctx, func() (err error) {
+		created, _, err = m.client.Issues.CreateMilestone(ctx, m.dstOwner, m.dstRepo, &github.Milestone{
+			Title:       milestone.Title,
+			Description: milestone.Description,
+			DueOn:       milestone.DueOn,
+			State:       milestone.State,
+		})
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("could not create %s milestone in %s/%s: %w", milestone.GetTitle(), m.dstOwner, m.dstRepo, IsRateLimitError(err))
+	}
+	m.milestones[created.GetTitle()] = created.GetNumber()
+	return created.GetNumber(), nil
+}
+
+// copyComments copies the comments of issue after the copied ones in progress.
+func (m *issueMigrator) copyComments(ctx context.Context, issue *github.Issue, progress *migrateIssue) error {
+	comments, err := listIssueComments(ctx, m.client, m.srcOwner, m.srcRepo, issue.GetNumber())
+	if err != nil {
+		return err
+	}
+	if progress.Comments > len(comments) {
+		// the source comments are deleted after the previous run
+		progress.Comments = len(comments)
+	}
+
+	for _, comment := range comments[progress.Comments:] {
+		body := fmt.Sprintf("_**%s** commented on %s:_\n\n%s",
+			comment.GetUser().GetLogin(), comment.GetCreatedAt().Format(time.RFC3339), comment.GetBody())
+		if err := m.pacer.do(ctx, func() error {
+			_, _, err := m.client.Issues.CreateComment(ctx, m.dstOwner, m.dstRepo, progress.Number, &github.IssueComment{Body: github.String(body)})
+			return err
+		}); err != nil {
+			return fmt.Errorf("could not copy comment %s: %w", comment.GetHTMLURL(), IsRateLimitError(err))
+		}
+		progress.Comments++
+		if err := m.mapping.save(m.mappingFile); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// closeSourceIssue comments the link to the copied issue on the source issue, and closes it.
+// Each step is recorded to progress, so the rerun does not comment twice.
+func (m *issueMigrator) closeSourceIssue(ctx context.Context, issue *github.Issue, progress *migrateIssue) error {
+	if !progress.SourceCommented {
+		body := fmt.Sprintf("Moved to %s/%s#%d.", m.dstOwner, m.dstRepo, progress.Number)
+		if err := m.pacer.do(ctx, func() error {
+			_, _, err := m.client.Issues.CreateComment(ctx, m.srcOwner, m.srcRepo, issue.GetNumber(), &github.IssueComment{Body: github.String(body)})
+			return err
+		}); err != nil {
+			return fmt.Errorf("could not comment on %s: %w", issue.GetHTMLURL(), IsRateLimitError(err))
+		}
+		progress.SourceCommented = true
+		if err := m.mapping.save(m.mappingFile); err != nil {
+			return err
+		}
+	}
+	if issue.GetState() != "closed" {
+		if err := m.pacer.do(ctx, func() error {
+			_, _, err := m.client.Issues.Edit(ctx, m.srcOwner, m.srcRepo, issue.GetNumber(), &github.IssueRequest{State: github.String("closed")})
+			return err
+		}); err != nil {
+			return fmt.Errorf("could not close %s: %w", issue.GetHTMLURL(), IsRateLimitError(err))
+		}
+	}
+	progress.SourceClosed = true
+	return m.mapping.save(m.mappingFile)
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v38/github"
+	"github.com/spf13/cobra"
+)
+
+type issueStateCmd struct {
+	ioStreams *IOStreams
+
+	state   string // closed or open
+	comment string
+}
+
+func init() {
+	issueCmd.AddCommand(newCmdIssueState("close", "closed", "Closes the issue"))
+	issueCmd.AddCommand(newCmdIssueState("reopen", "open", "Reopens the closed issue"))
+}
+
+func newCmdIssueState(use, state, short string) *cobra.Command {
+	c := &issueStateCmd{
+		ioStreams: defaultIOStreams,
+		state:     state,
+	}
+
+	cmd := &cobra.Command{
+		Use:   use + " <owner/repo> <number>",
+		Short: short,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkArgs(cmd, args, 2, exactArgs, "<owner/repo> <number>"); err != nil {
+				return err
+			}
+			owner, repo, number, err := parseRepositoryNumber(args)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			return c.runState(ctx, owner, repo, number)
+		},
+		Annotations:       noPagerAnnotation(),
+		ValidArgsFunction: completeRepositoryIssue,
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(&c.comment, "comment", "c", "", "comment the body on the issue before "+use)
+
+	return cmd
+}
+
+func (c *issueStateCmd) runState(ctx context.Context, owner, repo string, number int) error {
+	client := newClient(ctx)
+
+	issue, _, err := client.Issues.Get(ctx, owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("could not get %s/%s#%d issue: %w", owner, repo, number, IsRateLimitError(err))
+	}
+	if issue.IsPullRequest() {
+		return fmt.Errorf("%s/%s#%d is the pull request, not the issue", owner, repo, number)
+	}
+	if issue.GetState() == c.state {
+		fmt.Fprintf(c.ioStreams.ErrOut, "%s is already %s\n", issue.GetHTMLURL(), c.state)
+		return nil
+	}
+
+	if c.comment != "" {
+		if _, _, err := client.Issues.CreateComment(ctx, owner, repo, number, &github.IssueComment{Body: github.String(c.comment)}); err != nil {
+			return fmt.Errorf("could not comment on %s: %w", issue.GetHTMLURL(), IsRateLimitError(err))
+		}
+	}
+	if _, _, err := client.Issues.Edit(ctx, owner, repo, number, &github.IssueRequest{State: github.String(c.state)}); err != nil {
+		return fmt.Errorf("could not change the state of %s to %s: %w", issue.GetHTMLURL(), c.state, IsRateLimitError(err))
+	}
+
+	fmt.Fprintf(c.ioStreams.ErrOut, "%s: %s\n", issue.GetHTMLURL(), c.state)
+
+	return nil
+}
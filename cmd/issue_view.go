@@ -0,0 +1,178 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/go-github/v38/github"
+	"github.com/spf13/cobra"
+)
+
+type issueViewCmd struct {
+	ioStreams *IOStreams
+
+	comments bool
+	output   string
+}
+
+func init() {
+	issueCmd.AddCommand(newCmdIssueView())
+}
+
+func newCmdIssueView() *cobra.Command {
+	c := &issueViewCmd{
+		ioStreams: defaultIOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view <owner/repo> <number>",
+		Short: "Shows the description, labels, assignees and milestone of the issue",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkArgs(cmd, args, 2, exactArgs, "<owner/repo> <number>"); err != nil {
+				return err
+			}
+			owner, repo, number, err := parseRepositoryNumber(args)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			return c.runView(ctx, owner, repo, number)
+		},
+		ValidArgsFunction: completeRepositoryIssue,
+	}
+
+	f := cmd.Flags()
+	f.BoolVarP(&c.comments, "comments", "c", false, "show the comments of the issue")
+	f.StringVarP(&c.output, "output", "o", string(outputText), "output format. [text, json]")
+
+	return cmd
+}
+
+// issueComment represents the comment of the issue view command.
+type issueComment struct {
+	Author    string    `json:"author"`
+	Body      string    `json:"body"`
+	URL       string    `json:"url"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// issueView represents the issue of the issue view command.
+type issueView struct {
+	issueListItem
+	Owner        string         `json:"owner"`
+	Repo         string         `json:"repo"`
+	Body         string         `json:"body"`
+	ClosedAt     *time.Time     `json:"closed_at,omitempty"`
+	CommentsList []issueComment `json:"comments_list,omitempty"`
+}
+
+func (c *issueViewCmd) runView(ctx context.Context, owner, repo string, number int) error {
+	format, err := parseOutputFormat(c.output, outputText, outputJSON)
+	if err != nil {
+		return err
+	}
+
+	client := newClient(ctx)
+
+	issue, _, err := client.Issues.Get(ctx, owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("could not get %s/%s#%d issue: %w", owner, repo, number, IsRateLimitError(err))
+	}
+	if issue.IsPullRequest() {
+		return fmt.Errorf("%s/%s#%d is the pull request, use pr view command", owner, repo, number)
+	}
+
+	view := &issueView{
+		issueListItem: newIssueListItem(issue),
+		Owner:         owner,
+		Repo:          repo,
+		Body:          issue.GetBody(),
+		ClosedAt:      issue.ClosedAt,
+	}
+	if c.comments {
+		comments, err := listIssueComments(ctx, client, owner, repo, number)
+		if err != nil {
+			return err
+		}
+		for _, comment := range comments {
+			view.CommentsList = append(view.CommentsList, issueComment{
+				Author:    comment.GetUser().GetLogin(),
+				Body:      comment.GetBody(),
+				URL:       comment.GetHTMLURL(),
+				CreatedAt: comment.GetCreatedAt(),
+			})
+		}
+	}
+
+	if format == outputJSON {
+		return writeJSON(c.ioStreams.Out, view)
+	}
+	return writeIssueView(c.ioStreams.Out, view)
+}
+
+// listIssueComments lists the all comments of the issue in the created order.
+func listIssueComments(ctx context.Context, client *github.Client, owner, repo string, number int) ([]*github.IssueComment, error) {
+	var res []*github.IssueComment
+	opts := &github.IssueListCommentsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		comments, resp, err := client.Issues.ListComments(ctx, owner, repo, number, opts)
+		if err != nil {
+			return nil, fmt.Errorf("could not list %s/%s#%d comments: %w", owner, repo, number, IsRateLimitError(err))
+		}
+		res = append(res, comments...)
+		if resp.NextPage == 0 {
+			return res, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func writeIssueView(w io.Writer, view *issueView) error {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%s #%d\n", view.Title, view.Number)
+	fmt.Fprintf(&sb, "%s • %s opened %s • %d comments\n", view.State, view.Author, view.CreatedAt.Format(time.RFC3339), view.Comments)
+	fmt.Fprintf(&sb, "%s\n\n", view.URL)
+
+	orNone := func(ss []string) string {
+		if len(ss) == 0 {
+			return "-"
+		}
+		return strings.Join(ss, ", ")
+	}
+	milestone := view.Milestone
+	if milestone == "" {
+		milestone = "-"
+	}
+	tw := tabwriter.NewWriter(&sb, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "Labels:\t%s\n", orNone(view.Labels))
+	fmt.Fprintf(tw, "Assignees:\t%s\n", orNone(view.Assignees))
+	fmt.Fprintf(tw, "Milestone:\t%s\n", milestone)
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("could not flush tabwriter: %w", err)
+	}
+
+	body := strings.TrimSpace(view.Body)
+	if body == "" {
+		body = "No description provided."
+	}
+	fmt.Fprintf(&sb, "\n%s\n", body)
+
+	for _, comment := range view.CommentsList {
+		fmt.Fprintf(&sb, "\n--- %s commented %s\n%s\n", comment.Author, comment.CreatedAt.Format(time.RFC3339), strings.TrimSpace(comment.Body))
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
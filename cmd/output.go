@@ -0,0 +1,45 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// outputFormat represents the output format of the list commands.
+type outputFormat string
+
+const (
+	outputText     outputFormat = "text"
+	outputMarkdown outputFormat = "markdown"
+	outputJSON     outputFormat = "json"
+	outputHTML     outputFormat = "html"
+)
+
+// parseOutputFormat parses s as the one of allowed output formats.
+func parseOutputFormat(s string, allowed ...outputFormat) (outputFormat, error) {
+	names := make([]string, len(allowed))
+	for i, format := range allowed {
+		if string(format) == s {
+			return format, nil
+		}
+		names[i] = string(format)
+	}
+	return "", fmt.Errorf("unknown output format %q: must be one of [%s]", s, strings.Join(names, ", "))
+}
+
+// writeJSON writes the indented JSON encoding of v to w.
+func writeJSON(w io.Writer, v interface{}) error {
+	buf, err := json.MarshalIndent(v, "", "\t")
+	if err != nil {
+		return fmt.Errorf("could not marshal to JSON: %w", err)
+	}
+	buf = append(buf, '\n')
+	_, err = w.Write(buf)
+	return err
+}
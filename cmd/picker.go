@@ -0,0 +1,181 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v38/github"
+
+	"github.com/zchee/ghctl/pkg/cache"
+	"github.com/zchee/ghctl/pkg/picker"
+	"github.com/zchee/ghctl/pkg/spin"
+	"github.com/zchee/ghctl/pkg/term"
+)
+
+// repoCacheTTL is the lifetime of the cached repository list.
+const repoCacheTTL = 10 * time.Minute
+
+// newCache returns the cache stored in the default cache directory.
+func newCache(ttl time.Duration) (*cache.Cache, error) {
+	dir, err := cache.Dir()
+	if err != nil {
+		return nil, err
+	}
+	return cache.New(dir, ttl), nil
+}
+
+// canPrompt reports whether the streams is connected to the terminal for the interactive prompt.
+func canPrompt(streams *IOStreams) bool {
+	f, ok := streams.In.(*os.File)
+	return ok && term.IsTerminal(f.Fd())
+}
+
+// warnCache reports the cache error to stderr. The cache is best-effort, so the error does not fail the command.
+func warnCache(err error) {
+	fmt.Fprintf(defaultIOStreams.ErrOut, "warning: %v\n", err)
+}
+
+// authenticatedLogin returns the login name of the authenticated user.
+// The result is cached per token for repoCacheTTL.
+func authenticatedLogin(ctx context.Context, client *github.Client) (string, error) {
+	c, err := newCache(repoCacheTTL)
+	if err != nil {
+		warnCache(err)
+	}
+	key := "login:" + tokenFromEnv()
+	var login string
+	if c != nil {
+		if ok, _ := c.Get(key, &login); ok && login != "" {
+			return login, nil
+		}
+	}
+
+	user, err := getUser(ctx, client)
+	if err != nil {
+		return "", fmt.Errorf("could not get user information: %w", err)
+	}
+	login = user.GetLogin()
+	if c != nil {
+		if err := c.Set(key, login); err != nil {
+			warnCache(err)
+		}
+	}
+
+	return login, nil
+}
+
+// userRepositoriesCacheKey returns the cache key of the login user repositories.
+func userRepositoriesCacheKey(login string) string {
+	return "repos:" + login
+}
+
+// userRepositoryNames returns the sorted full names of the authenticated user repositories.
+// The result is cached for repoCacheTTL.
+func userRepositoryNames(ctx context.Context, client *github.Client, streams *IOStreams) ([]string, error) {
+	login, err := authenticatedLogin(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	key := userRepositoriesCacheKey(login)
+
+	c, err := newCache(repoCacheTTL)
+	if err != nil {
+		warnCache(err)
+	}
+	var names []string
+	if c != nil {
+		if ok, _ := c.Get(key, &names); ok {
+			return names, nil
+		}
+	}
+
+	progress := spin.NewProgress(streams.ErrOut)
+	progress.Start()
+	repos, err := listRepositories(ctx, client, "", github.RepositoryListOptions{Type: "all"}, true, progress)
+	progress.Stop()
+	if err != nil {
+		return nil, err
+	}
+
+	names = make([]string, len(repos))
+	for i, repo := range repos {
+		names[i] = repo.GetFullName()
+	}
+	sort.Strings(names)
+
+	if c != nil {
+		if err := c.Set(key, names); err != nil {
+			warnCache(err)
+		}
+	}
+
+	return names, nil
+}
+
+// forgetUserRepositories removes the cached repository list of the authenticated user.
+func forgetUserRepositories(ctx context.Context, client *github.Client) error {
+	login, err := authenticatedLogin(ctx, client)
+	if err != nil {
+		return err
+	}
+	c, err := newCache(repoCacheTTL)
+	if err != nil {
+		return err
+	}
+	return c.Delete(userRepositoriesCacheKey(login))
+}
+
+// pickRepositories runs the interactive fuzzy finder over the authenticated user repositories
+// and returns the chosen owner/repo names.
+func pickRepositories(ctx context.Context, client *github.Client, streams *IOStreams, multi bool) ([]string, error) {
+	names, err := userRepositoryNames(ctx, client, streams)
+	if err != nil {
+		return nil, err
+	}
+
+	prompt := "repository> "
+	if multi {
+		prompt = "repositories (Tab to select)> "
+	}
+	p := picker.New(streams.In.(*os.File), streams.ErrOut, prompt, multi)
+
+	return p.Pick(names)
+}
+
+// confirm asks the yes/no question of prompt on streams, and reports whether the answer is yes.
+func confirm(streams *IOStreams, prompt string) (bool, error) {
+	fmt.Fprintf(streams.ErrOut, "%s (y,n) ", prompt)
+	answer, err := bufio.NewReader(streams.In).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, err
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// confirmCount asks to type the number n of the items on streams to confirm the destructive operation,
+// and reports whether the typed number is n.
+func confirmCount(streams *IOStreams, prompt string, n int) (bool, error) {
+	fmt.Fprintf(streams.ErrOut, "%s type %d to confirm: ", prompt, n)
+	answer, err := bufio.NewReader(streams.In).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, err
+	}
+	return strings.TrimSpace(answer) == strconv.Itoa(n), nil
+}
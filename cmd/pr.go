@@ -7,15 +7,22 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/google/go-github/v38/github"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/zchee/ghctl/pkg/config"
+	"github.com/zchee/ghctl/pkg/ignore"
+	"github.com/zchee/ghctl/pkg/report"
 	"github.com/zchee/ghctl/pkg/spin"
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/sync/semaphore"
@@ -29,8 +36,8 @@ var prCmd = &cobra.Command{
 
 var (
 	prListCmd = &cobra.Command{
-		Use:   "list",
-		Short: "List the your sent pull requests",
+		Use:   "list [owner|owner/repo]...",
+		Short: "List the your sent pull requests, or pull requests of any users and involvement modes",
 		Run: func(cmd *cobra.Command, args []string) {
 			if err := runPullRequestList(cmd, args); err != nil {
 				cmd.Println(err)
@@ -39,8 +46,8 @@ var (
 	}
 
 	prGetCmd = &cobra.Command{
-		Use:   "get",
-		Short: "Gets you send pull requests from the specific repository",
+		Use:   "get <owner> <repo>",
+		Short: "Gets the pull requests from the specific repository",
 		Run: func(cmd *cobra.Command, args []string) {
 			if err := runPullRequestGet(cmd, args); err != nil {
 				cmd.Println(err)
@@ -52,11 +59,36 @@ var (
 var (
 	prIgnoreOwners []string
 	prIgnoreRepos  []string
+	prIgnoreFile   string
 	prReverse      bool
 	prMarkdown     bool
 	prAll          bool
-
-	prGetMarkdown bool
+	prState        string
+	prOutput       string
+	prSince        string
+	prUntil        string
+	prDateField    string
+	prLabels       []string
+	prExcludeLabel []string
+	prBase         string
+	prDraft        bool
+
+	prAuthor          string
+	prReviewedBy      string
+	prReviewRequested string
+	prAssignee        string
+	prInvolves        string
+	prOrgs            []string
+
+	prGetMarkdown  bool
+	prGetState     string
+	prGetBase      string
+	prGetHead      string
+	prGetSort      string
+	prGetDirection string
+	prGetAuthor    string
+	prGetLabels    []string
+	prGetLimit     int
 )
 
 func init() {
@@ -65,41 +97,141 @@ func init() {
 	prCmd.AddCommand(prListCmd)
 	prCmd.AddCommand(prGetCmd)
 
-	prListCmd.Flags().StringSliceVar(&prIgnoreOwners, "ignore-owner", nil, "ignore any owner repositories")
-	prListCmd.Flags().StringSliceVar(&prIgnoreRepos, "ignore-repo", nil, "ignore any repository")
-	prListCmd.Flags().BoolVar(&prReverse, "reverse", false, "reverse of sort order")
-	prListCmd.Flags().BoolVarP(&prMarkdown, "markdown", "m", false, "output markdown syntax")
-	prListCmd.Flags().BoolVarP(&prAll, "all", "a", false, "output all pull request (default: merged)")
+	prListCmd.Flags().BoolVarP(&prMarkdown, "markdown", "m", false, "output markdown syntax. same as --output=markdown")
+	prListCmd.Flags().StringVarP(&prOutput, "output", "o", string(outputText), "output format. [text, markdown, json, html]")
+	addPullRequestSearchFlags(prListCmd.Flags())
 
 	prGetCmd.Flags().BoolVarP(&prGetMarkdown, "markdown", "m", false, "output markdown syntax")
+	prGetCmd.Flags().StringVar(&prGetState, "state", "closed", "state of pull requests. [open, closed, all]")
+	prGetCmd.Flags().StringVar(&prGetBase, "base", "", "filter pull requests by the base branch name")
+	prGetCmd.Flags().StringVar(&prGetHead, "head", "", "filter pull requests by the head user or organization and branch name as user:ref-name. the user defaults to the owner")
+	prGetCmd.Flags().StringVar(&prGetSort, "sort", "created", "sort order of pull requests. [created, updated, popularity, long-running]")
+	prGetCmd.Flags().StringVar(&prGetDirection, "direction", "asc", "direction of the sort order. [asc, desc]")
+	prGetCmd.Flags().StringVar(&prGetAuthor, "author", "", "filter pull requests by the author login")
+	prGetCmd.Flags().StringSliceVar(&prGetLabels, "label", nil, "filter pull requests which have all of the labels")
+	prGetCmd.Flags().IntVarP(&prGetLimit, "limit", "L", 0, "maximum number of pull requests. stops paginating once reached. zero means no limit")
+}
+
+// addPullRequestSearchFlags adds the pull request search flags shared by the pr list and pr stats commands to fs.
+func addPullRequestSearchFlags(fs *pflag.FlagSet) {
+	fs.StringSliceVar(&prIgnoreOwners, "ignore-owner", nil, "ignore any owner repositories. accepts glob or regexp with re: prefix")
+	fs.StringSliceVar(&prIgnoreRepos, "ignore-repo", nil, "ignore any repository. accepts owner/repo glob or regexp with re: prefix")
+	fs.StringVar(&prIgnoreFile, "ignore-file", "", "file of ignore rules, one per line (default: ignore file in the config directory)")
+	fs.BoolVar(&prReverse, "reverse", false, "reverse of sort order")
+	fs.BoolVarP(&prAll, "all", "a", false, "output all pull request. same as --state=all")
+	fs.StringVar(&prState, "state", string(pullRequestStateMerged), "state of pull requests to list. [open, merged, closed-unmerged, all]")
+	fs.StringVar(&prSince, "since", "", "list pull requests on or after the date. (YYYY-MM-DD or RFC3339)")
+	fs.StringVar(&prUntil, "until", "", "list pull requests on or before the date. (YYYY-MM-DD or RFC3339)")
+	fs.StringVar(&prDateField, "date-field", "created", "date field which --since and --until filters. [created, updated, merged]")
+	fs.StringSliceVar(&prLabels, "label", nil, "list pull requests which have all of the labels")
+	fs.StringSliceVar(&prExcludeLabel, "exclude-label", nil, "list pull requests which do not have any of the labels")
+	fs.StringVar(&prBase, "base", "", "list pull requests which target the base branch")
+	fs.BoolVar(&prDraft, "draft", false, "list draft pull requests only. --draft=false lists non-draft pull requests only")
+	fs.StringVar(&prAuthor, "author", "", "list pull requests created by the user (default: authenticated user, unless any involvement flag is set)")
+	fs.StringVar(&prReviewedBy, "reviewed-by", "", "list pull requests reviewed by the user")
+	fs.StringVar(&prReviewRequested, "review-requested", "", "list pull requests which requested review to the user or team (org/team)")
+	fs.StringVar(&prAssignee, "assignee", "", "list pull requests assigned to the user")
+	fs.StringVar(&prInvolves, "involves", "", "list pull requests which involve the user as author, assignee, mentions or commenter")
+	fs.StringSliceVar(&prOrgs, "org", nil, "list pull requests in the organization repositories")
 }
 
 type pullRequestState string
 
 const (
-	pullRequestStateOpen   pullRequestState = "open"
-	pullRequestStateClosed pullRequestState = "closed"
+	pullRequestStateOpen           pullRequestState = "open"
+	pullRequestStateMerged         pullRequestState = "merged"
+	pullRequestStateClosedUnmerged pullRequestState = "closed-unmerged"
+	pullRequestStateAll            pullRequestState = "all"
 )
 
-func runPullRequestList(cmd *cobra.Command, args []string) error {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+// pullRequestStates is the list of the pull request states except pullRequestStateAll.
+var pullRequestStates = []pullRequestState{
+	pullRequestStateOpen,
+	pullRequestStateMerged,
+	pullRequestStateClosedUnmerged,
+}
 
-	client := newClient(ctx)
-	s := spin.NewSpin()
+// parsePullRequestState parses s as the pullRequestState.
+func parsePullRequestState(s string) (pullRequestState, error) {
+	switch state := pullRequestState(s); state {
+	case pullRequestStateOpen, pullRequestStateMerged, pullRequestStateClosedUnmerged, pullRequestStateAll:
+		return state, nil
+	}
+	return "", fmt.Errorf("unknown pull request state %q: must be one of [open, merged, closed-unmerged, all]", s)
+}
 
-	user, err := getUser(ctx, client)
+// expand returns the states which s contains.
+func (s pullRequestState) expand() []pullRequestState {
+	if s == pullRequestStateAll {
+		return pullRequestStates
+	}
+	return []pullRequestState{s}
+}
+
+// qualifier returns the search qualifier of s.
+//
+// The state:closed qualifier matches both merged and closed-unmerged pull requests,
+// so uses the is:merged and is:unmerged qualifiers.
+func (s pullRequestState) qualifier() string {
+	switch s {
+	case pullRequestStateOpen:
+		return "is:open"
+	case pullRequestStateMerged:
+		return "is:merged"
+	case pullRequestStateClosedUnmerged:
+		return "is:closed is:unmerged"
+	}
+	return ""
+}
+
+// pullRequestListItem represents the pull request of the pr list command result.
+type pullRequestListItem struct {
+	Owner     string    `json:"owner"`
+	Repo      string    `json:"repo"`
+	Number    int       `json:"number"`
+	Title     string    `json:"title"`
+	URL       string    `json:"url"`
+	State     string    `json:"state"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func newPullRequestListItem(issue *github.Issue, state pullRequestState) pullRequestListItem {
+	owner, repo := getRepoOwnerAndName(issue.GetURL())
+	return pullRequestListItem{
+		Owner:     owner,
+		Repo:      repo,
+		Number:    issue.GetNumber(),
+		Title:     issue.GetTitle(),
+		URL:       issue.GetHTMLURL(),
+		State:     string(state),
+		CreatedAt: issue.GetCreatedAt(),
+		UpdatedAt: issue.GetUpdatedAt(),
+	}
+}
+
+func runPullRequestList(cmd *cobra.Command, args []string) error {
+	format, err := parseOutputFormat(prOutput, outputText, outputMarkdown, outputJSON, outputHTML)
 	if err != nil {
 		return err
 	}
-
-	repos := []string{}
-	if len(args) > 0 {
-		repos = args
+	if prMarkdown {
+		format = outputMarkdown
+	}
+	state, err := parsePullRequestListState()
+	if err != nil {
+		return err
+	}
+	search, err := newPullRequestSearch(cmd, args)
+	if err != nil {
+		return err
 	}
 
-	buf := new(bytes.Buffer)
-	page := 1
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := newClient(ctx)
+	s := spin.NewSpin()
 
 	done := make(chan struct{}, 1)
 	go func() {
@@ -114,70 +246,224 @@ func runPullRequestList(cmd *cobra.Command, args []string) error {
 		}
 	}()
 
-	if err := getPullRequest(ctx, client, buf, user.GetLogin(), repos, pullRequestStateClosed, page); err != nil {
+	items, err := listPullRequestItems(ctx, client, search, state)
+	done <- struct{}{}
+	s.Flush()
+	if err != nil {
 		return err
 	}
+
+	return writePullRequestList(defaultIOStreams.Out, format, items)
+}
+
+// parsePullRequestListState returns the pullRequestState from the --state and --all flags.
+func parsePullRequestListState() (pullRequestState, error) {
+	state, err := parsePullRequestState(prState)
+	if err != nil {
+		return "", err
+	}
 	if prAll {
-		if err := getPullRequest(ctx, client, buf, user.GetLogin(), repos, pullRequestStateOpen, page); err != nil {
-			return err
+		state = pullRequestStateAll
+	}
+	return state, nil
+}
+
+// listPullRequestItems searches the pull requests of state which match search, and returns them sorted by updated time.
+// If search has no author and involvement qualifiers, the authenticated user is used as the author.
+func listPullRequestItems(ctx context.Context, client *github.Client, search *pullRequestSearch, state pullRequestState) ([]pullRequestListItem, error) {
+	if search.author == "" && !search.hasInvolvement() {
+		user, err := getUser(ctx, client)
+		if err != nil {
+			return nil, err
 		}
+		search.author = user.GetLogin()
+	}
+
+	var items []pullRequestListItem
+	for _, state := range state.expand() {
+		prs, err := searchPullRequests(ctx, client, search, state)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, prs...)
 	}
-	done <- struct{}{}
-	s.Flush()
 
-	fmt.Fprint(os.Stdout, buf.String())
+	sort.SliceStable(items, func(i, j int) bool {
+		if prReverse {
+			return items[i].UpdatedAt.After(items[j].UpdatedAt)
+		}
+		return items[i].UpdatedAt.Before(items[j].UpdatedAt)
+	})
 
-	return nil
+	return items, nil
 }
 
-func getPullRequest(ctx context.Context, client *github.Client, buf io.Writer, username string, repos []string, state pullRequestState, page int) error {
-	order := "asc"
+// newPullRequestSearch returns the pullRequestSearch from the pr list command flags and args.
+func newPullRequestSearch(cmd *cobra.Command, args []string) (*pullRequestSearch, error) {
+	search := &pullRequestSearch{
+		author:          prAuthor,
+		reviewedBy:      prReviewedBy,
+		reviewRequested: prReviewRequested,
+		assignee:        prAssignee,
+		involves:        prInvolves,
+		orgs:            prOrgs,
+		repos:           args,
+		dateField:       prDateField,
+		labels:          prLabels,
+		excludeLabels:   prExcludeLabel,
+		base:            prBase,
+		sort:            "updated",
+		order:           "asc",
+	}
 	if prReverse {
-		order = "desc"
+		search.order = "desc"
 	}
-	options := &github.SearchOptions{
-		Sort:  "updated",
-		Order: order,
-		ListOptions: github.ListOptions{
-			Page: page,
-		},
+	if !matchSlice(prDateField, dateFields) {
+		return nil, fmt.Errorf("unknown date field %q: must be one of [%s]", prDateField, strings.Join(dateFields, ", "))
 	}
 
-	sep := " "
-	query := "author:" + username + sep + "state:" + string(state) + sep + "type:pr"
-	if len(repos) > 0 {
-		for _, repo := range repos {
-			if strings.Contains(repo, "/") {
-				query += sep + "repo:" + repo
-			} else {
-				query += sep + "user:" + repo
-			}
-		}
+	var err error
+	if search.since, err = parseSearchDate(prSince, false); err != nil {
+		return nil, err
+	}
+	if search.until, err = parseSearchDate(prUntil, true); err != nil {
+		return nil, err
+	}
+	if !search.since.IsZero() && !search.until.IsZero() && search.until.Before(search.since) {
+		return nil, fmt.Errorf("--until %s is before --since %s", prUntil, prSince)
+	}
+	if cmd.Flags().Changed("draft") {
+		draft := prDraft
+		search.draft = &draft
 	}
-	prs, resp, err := client.Search.Issues(ctx, query, options)
+
+	if search.ignore, err = newPullRequestIgnoreRules(); err != nil {
+		return nil, err
+	}
+
+	return search, nil
+}
+
+// newPullRequestIgnoreRules returns the ignore rules from the --ignore-owner, --ignore-repo and --ignore-file flags.
+func newPullRequestIgnoreRules() (*ignore.Rules, error) {
+	rules, err := ignore.New()
 	if err != nil {
-		return fmt.Errorf("could not get search pull request result: %w", IsRateLimitError(err))
+		return nil, err
 	}
 
-	for _, pr := range prs.Issues {
-		// TODO(zchee): check flag whether the nil
-		owner, repo := getRepoOwnerAndName(pr.GetURL())
-		if matchSlice(owner, prIgnoreOwners) || matchSlice(repo, prIgnoreOwners) {
-			continue
+	for _, owner := range prIgnoreOwners {
+		if !strings.HasPrefix(owner, "re:") && strings.Contains(owner, "/") {
+			return nil, fmt.Errorf("--ignore-owner %q must not contain \"/\", use --ignore-repo instead", owner)
 		}
-		if prMarkdown {
-			buf.Write([]byte(fmt.Sprintf("- [%s](%s)\n", pr.GetTitle(), pr.GetHTMLURL())))
-			continue
+		if err := rules.Add(owner); err != nil {
+			return nil, err
+		}
+	}
+	for _, repo := range prIgnoreRepos {
+		if !strings.HasPrefix(repo, "re:") && !strings.Contains(repo, "/") {
+			repo = "*/" + repo // repository name of any owner
+		}
+		if err := rules.Add(repo); err != nil {
+			return nil, err
 		}
-		buf.Write([]byte(fmt.Sprintf("url: %s, created: %s, title: %s\n", pr.GetHTMLURL(), pr.GetCreatedAt(), pr.GetTitle())))
 	}
 
-	if page == resp.LastPage || resp.NextPage == 0 {
-		return nil
+	fname := prIgnoreFile
+	if fname == "" {
+		if fname, err = config.Path("ignore"); err != nil {
+			return nil, err
+		}
+	} else if _, err := os.Stat(fname); err != nil {
+		return nil, fmt.Errorf("could not read ignore file: %w", err)
+	}
+	if err := rules.Load(fname); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// writePullRequestList writes the items to w with format.
+func writePullRequestList(w io.Writer, format outputFormat, items []pullRequestListItem) error {
+	switch format {
+	case outputJSON:
+		return writeJSON(w, items)
+
+	case outputHTML:
+		return pullRequestListReport(items).WriteHTML(w)
+
+	default:
+		buf := new(bytes.Buffer)
+		for _, item := range items {
+			if format == outputMarkdown {
+				buf.WriteString(fmt.Sprintf("- [%s](%s) (%s)\n", item.Title, item.URL, item.State))
+				continue
+			}
+			buf.WriteString(fmt.Sprintf("url: %s, state: %s, created: %s, title: %s\n", item.URL, item.State, github.Timestamp{Time: item.CreatedAt}, item.Title))
+		}
+
+		// summary footer
+		counts := countPullRequestStates(items)
+		summary := make([]string, 0, len(counts)+1)
+		for _, count := range counts {
+			summary = append(summary, fmt.Sprintf("%s: %d", count.Label, count.Count))
+		}
+		summary = append(summary, fmt.Sprintf("total: %d", len(items)))
+		buf.WriteString("\n" + strings.Join(summary, ", ") + "\n")
+
+		_, err := w.Write(buf.Bytes())
+		return err
+	}
+}
+
+// countPullRequestStates counts the items per state in the order of pullRequestStates.
+func countPullRequestStates(items []pullRequestListItem) []report.Count {
+	counts := make(map[string]int)
+	for _, item := range items {
+		counts[item.State]++
+	}
+
+	res := make([]report.Count, 0, len(pullRequestStates))
+	for _, state := range pullRequestStates {
+		if n, ok := counts[string(state)]; ok {
+			res = append(res, report.Count{Label: string(state), Count: n})
+		}
 	}
-	page = resp.NextPage
 
-	return getPullRequest(ctx, client, buf, username, repos, state, page)
+	return res
+}
+
+// pullRequestListReport returns the HTML report of items grouped by owner/repo.
+func pullRequestListReport(items []pullRequestListItem) *report.Report {
+	r := &report.Report{
+		Title:   "Pull Requests",
+		Columns: []string{"#", "Title", "State", "Created", "Updated"},
+	}
+
+	groups := make(map[string]int)
+	for _, item := range items {
+		name := item.Owner + "/" + item.Repo
+		i, ok := groups[name]
+		if !ok {
+			i = len(r.Groups)
+			groups[name] = i
+			r.Groups = append(r.Groups, report.Group{
+				Name: name,
+				Link: "https://github.com/" + name,
+			})
+		}
+		r.Groups[i].Rows = append(r.Groups[i].Rows, []report.Cell{
+			{Text: strconv.Itoa(item.Number), Link: item.URL},
+			{Text: item.Title, Link: item.URL},
+			{Text: item.State},
+			{Text: item.CreatedAt.Format("2006-01-02"), SortKey: item.CreatedAt.Format(time.RFC3339)},
+			{Text: item.UpdatedAt.Format("2006-01-02"), SortKey: item.UpdatedAt.Format(time.RFC3339)},
+		})
+	}
+	sort.Slice(r.Groups, func(i, j int) bool { return r.Groups[i].Name < r.Groups[j].Name })
+	r.Summary = countPullRequestStates(items)
+
+	return r
 }
 
 // getRepoOwnerAndName returns the repository owner and name.
@@ -199,15 +485,26 @@ func matchSlice(s string, sepsl []string) bool {
 }
 
 func runPullRequestGet(cmd *cobra.Command, args []string) error {
+	if err := checkArgs(cmd, args, 2, exactArgs, "<owner> <repo>"); err != nil {
+		return err
+	}
+	owner := args[0]
+	repo := args[1]
+
+	opts, err := newPullRequestGetOptions(owner)
+	if err != nil {
+		return err
+	}
+	if prGetLimit < 0 {
+		return errors.New("--limit flag must be zero or positive")
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
 	client := newClient(ctx)
 	s := spin.NewSpin()
 
-	owner := args[0]
-	repo := args[1]
-
 	done := make(chan struct{}, 1)
 	go func(s *spin.Spin) {
 		for {
@@ -221,12 +518,29 @@ func runPullRequestGet(cmd *cobra.Command, args []string) error {
 		}
 	}(s)
 
-	prs, err := listPullRequests(ctx, client, owner, repo)
-	if err != nil {
-		return err
+	// filter is nil if no client side filter is set, so the limited listing can fetch only the limit
+	var filter func(*github.PullRequest) bool
+	if prGetAuthor != "" || len(prGetLabels) > 0 {
+		filter = filterPullRequestGet
+	}
+
+	var prs []*github.PullRequest
+	if prGetLimit > 0 {
+		prs, err = listPullRequestsLimit(ctx, client, owner, repo, opts, prGetLimit, filter)
+	} else {
+		prs, err = listPullRequests(ctx, client, owner, repo, opts)
+		if filter != nil {
+			prs = filterPullRequests(prs, filter)
+		}
 	}
 	done <- struct{}{}
 	s.Flush()
+	if err != nil {
+		return err
+	}
+	if len(prs) == 0 {
+		return fmt.Errorf("not found pull requests from %s/%s repository", owner, repo)
+	}
 
 	builder := new(strings.Builder)
 	for _, pr := range prs {
@@ -237,21 +551,99 @@ func runPullRequestGet(cmd *cobra.Command, args []string) error {
 		builder.WriteString(fmt.Sprintf("url: %s, created: %s, title: %s\n", pr.GetHTMLURL(), pr.GetCreatedAt(), pr.GetTitle()))
 	}
 
-	// fmt.Fprintf(os.Stdout, "prs: %s", spew.Sdump(prs))
-	fmt.Fprint(os.Stdout, builder.String())
+	fmt.Fprint(defaultIOStreams.Out, builder.String())
 
 	return nil
 }
 
-// listPullRequests lists the merged pull request from the github.com/owner/repo repository.
-func listPullRequests(ctx context.Context, client *github.Client, owner string, repo string) ([]*github.PullRequest, error) {
-	var reponame = owner + "/" + repo
+// newPullRequestGetOptions returns the list options of the pr get command flags.
+func newPullRequestGetOptions(owner string) (*github.PullRequestListOptions, error) {
+	if !matchSlice(prGetState, []string{"open", "closed", "all"}) {
+		return nil, fmt.Errorf("invalid --state flag value %q: must be one of open, closed, all", prGetState)
+	}
+	if !matchSlice(prGetSort, []string{"created", "updated", "popularity", "long-running"}) {
+		return nil, fmt.Errorf("invalid --sort flag value %q: must be one of created, updated, popularity, long-running", prGetSort)
+	}
+	if !matchSlice(prGetDirection, []string{"asc", "desc"}) {
+		return nil, fmt.Errorf("invalid --direction flag value %q: must be one of asc, desc", prGetDirection)
+	}
 
-	opts := &github.PullRequestListOptions{
-		State:     "closed",
-		Sort:      "created", // TODO(zchee): Needs set `Base` field for release branch name?
-		Direction: "asc",     // TODO(zchee): desc?
+	head := prGetHead
+	if head != "" && !strings.Contains(head, ":") {
+		head = owner + ":" + head
+	}
+
+	return &github.PullRequestListOptions{
+		State:       prGetState,
+		Head:        head,
+		Base:        prGetBase,
+		Sort:        prGetSort,
+		Direction:   prGetDirection,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}, nil
+}
+
+// filterPullRequestGet reports whether pr matches the --author and --label flags,
+// which the list pull requests API does not support.
+func filterPullRequestGet(pr *github.PullRequest) bool {
+	if prGetAuthor != "" && !strings.EqualFold(pr.GetUser().GetLogin(), prGetAuthor) {
+		return false
+	}
+	for _, label := range prGetLabels {
+		if !hasAnyLabel(pr.Labels, []string{label}) {
+			return false
+		}
+	}
+	return true
+}
+
+// filterPullRequests returns the pull requests of prs which match fn.
+func filterPullRequests(prs []*github.PullRequest, fn func(*github.PullRequest) bool) []*github.PullRequest {
+	var res []*github.PullRequest
+	for _, pr := range prs {
+		if fn(pr) {
+			res = append(res, pr)
+		}
+	}
+	return res
+}
+
+// listPullRequestsLimit lists up to limit pull requests which match opts and fn from the github.com/owner/repo repository.
+// The nil fn matches the all pull requests, and fetches the page of limit size.
+// Unlike listPullRequests, the pages are fetched sequentially and stop once limit pull requests are found.
+func listPullRequestsLimit(ctx context.Context, client *github.Client, owner, repo string, opts *github.PullRequestListOptions, limit int, fn func(*github.PullRequest) bool) ([]*github.PullRequest, error) {
+	copyopt := *opts // copy
+	opts = &copyopt
+	if opts.PerPage == 0 || (opts.PerPage > limit && fn == nil) {
+		opts.PerPage = limit
+	}
+
+	var res []*github.PullRequest
+	for {
+		prs, resp, err := client.PullRequests.List(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed get list of pull request from %s/%s repository: %w", owner, repo, IsRateLimitError(err))
+		}
+		for _, pr := range prs {
+			if fn != nil && !fn(pr) {
+				continue
+			}
+			res = append(res, pr)
+			if len(res) == limit {
+				return res, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return res, nil
+		}
+		opts.Page = resp.NextPage
 	}
+}
+
+// listPullRequests lists the pull requests which match opts from the github.com/owner/repo repository.
+// The pages after the first page are fetched concurrently, and the result keeps the order of pages.
+func listPullRequests(ctx context.Context, client *github.Client, owner string, repo string, opts *github.PullRequestListOptions) ([]*github.PullRequest, error) {
+	var reponame = owner + "/" + repo
 
 	prs, resp, err := client.PullRequests.List(ctx, owner, repo, opts)
 	if err != nil {
@@ -263,22 +655,16 @@ func listPullRequests(ctx context.Context, client *github.Client, owner string,
 
 	lastPage := resp.LastPage
 	if lastPage == 0 {
-		return nil, fmt.Errorf("not found pull requests from %s repository", reponame)
+		return prs, nil // only one page
 	}
-	lastPage-- // decrease for already fetched page 1
-
-	// make channel with size of lastPage for concurrency fetching
-	prch := make(chan []*github.PullRequest, lastPage)
-	go func() { prch <- prs }() // send first pull requests result
 
-	// wg := new(sync.WaitGroup)
-	// wg.Add(lastPage)
-	// errc := make(chan error)
+	// make slice with size of lastPage for concurrency fetching
+	pages := make([][]*github.PullRequest, lastPage)
+	pages[0] = prs // first pull requests result
 
 	var eg *errgroup.Group
 	eg, ctx = errgroup.WithContext(ctx)
 	sem := make(chan struct{}, 20) // for concurrency API access limit
-	defer close(sem)
 
 	fn := func(i int, opts *github.PullRequestListOptions) error {
 		defer func() {
@@ -294,11 +680,12 @@ func listPullRequests(ctx context.Context, client *github.Client, owner string,
 			return fmt.Errorf("failed to get %d pages pull requests from %s repository: status code %d: %w", i, reponame, code, err)
 		}
 
-		prch <- prs
+		pages[i-1] = prs
 		return nil
 	}
 
-	for i := 0; i < lastPage; i++ {
+	// paging is based 1, and page 1 is already fetched
+	for i := 2; i <= lastPage; i++ {
 		sem <- struct{}{}
 		i := i
 		copyopt := *opts // copy
@@ -307,22 +694,15 @@ func listPullRequests(ctx context.Context, client *github.Client, owner string,
 		})
 	}
 
-	go func() {
-		for {
-			select {
-			case pr := <-prch:
-				prs = append(prs, pr...)
-			case <-ctx.Done():
-				close(prch)
-				return
-			}
-		}
-	}()
-
 	if err := eg.Wait(); err != nil {
 		return nil, err
 	}
 
+	prs = nil
+	for _, page := range pages {
+		prs = append(prs, page...)
+	}
+
 	return prs, nil
 }
 
@@ -0,0 +1,360 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/google/go-github/v38/github"
+	"github.com/spf13/cobra"
+
+	"github.com/zchee/ghctl/pkg/codeowners"
+	"github.com/zchee/ghctl/pkg/config"
+)
+
+type pullRequestAssignReviewersCmd struct {
+	ioStreams *IOStreams
+
+	team       string
+	count      int
+	dryRun     bool
+	configFile string
+}
+
+func init() {
+	prCmd.AddCommand(newCmdPullRequestAssignReviewers())
+}
+
+func newCmdPullRequestAssignReviewers() *cobra.Command {
+	c := &pullRequestAssignReviewersCmd{
+		ioStreams: defaultIOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "assign-reviewers <owner/repo> <number>",
+		Short: "Requests reviews from the code owners of the changed files, or the least loaded members of the team",
+		Long: `Requests reviews from the code owners of the changed files of the pull request.
+
+The code owners are read from the CODEOWNERS file of the base branch. The code owners which are already requested
+are skipped, and so are the teams of the other organization, which can not be requested.
+If no code owner matches, the reviewers are picked from the fallback team, which is the --team flag
+or the reviewers section of the config file. The members with the fewest open pull requests which directly request
+their reviews in the all repositories of the team's organization are picked first, and the ties are broken round-robin
+by the pull request number.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkArgs(cmd, args, 2, exactArgs, "<owner/repo> <number>"); err != nil {
+				return err
+			}
+			owner, repo, number, err := parseRepositoryNumber(args)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			return c.runAssignReviewers(ctx, cmd, owner, repo, number)
+		},
+		Annotations:       noPagerAnnotation(),
+		ValidArgsFunction: completeRepositoryPullRequest,
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&c.team, "team", "", "fallback org/team if no code owner matches (default: reviewers config)")
+	f.IntVar(&c.count, "count", 1, "number of reviewers picked from the fallback team")
+	f.BoolVar(&c.dryRun, "dry-run", false, "print the reviewers without requesting reviews")
+	f.StringVar(&c.configFile, "config", "", "path of the config file which has the reviewers section (default: config.json in the config directory)")
+
+	return cmd
+}
+
+// reviewerCandidate represents the picked reviewer and the reason.
+type reviewerCandidate struct {
+	name   string // login, or org/team
+	reason string
+}
+
+func (c *pullRequestAssignReviewersCmd) runAssignReviewers(ctx context.Context, cmd *cobra.Command, owner, repo string, number int) error {
+	cfg, err := config.Load(c.configFile)
+	if err != nil {
+		return err
+	}
+	team := c.team
+	if team == "" {
+		team = cfg.Reviewers.TeamOf(owner + "/" + repo)
+	}
+	if !cmd.Flags().Changed("count") && cfg.Reviewers.Count > 0 {
+		c.count = cfg.Reviewers.Count
+	}
+	if c.count <= 0 {
+		return errors.New("--count flag must be positive")
+	}
+
+	client := newClient(ctx)
+
+	pr, _, err := client.PullRequests.Get(ctx, owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("could not get %s/%s#%d pull request: %w", owner, repo, number, IsRateLimitError(err))
+	}
+	author := pr.GetUser().GetLogin()
+
+	owners, err := getCodeOwners(ctx, client, owner, repo, pr.GetBase().GetRef())
+	if err != nil {
+		return err
+	}
+
+	// skip the author and the already requested reviewers
+	skip := map[string]bool{strings.ToLower(author): true}
+	for _, user := range pr.RequestedReviewers {
+		skip[strings.ToLower(user.GetLogin())] = true
+	}
+	for _, team := range pr.RequestedTeams {
+		skip[strings.ToLower(owner+"/"+team.GetSlug())] = true
+	}
+
+	var candidates []reviewerCandidate
+	if owners != nil {
+		files, err := listPullRequestFiles(ctx, client, owner, repo, number)
+		if err != nil {
+			return err
+		}
+		requested := 0
+		for _, candidate := range codeOwnerCandidates(owners, files) {
+			// the review request accepts only the teams of the repository owner
+			if i := strings.IndexByte(candidate.name, '/'); i >= 0 && !strings.EqualFold(candidate.name[:i], owner) {
+				fmt.Fprintf(c.ioStreams.ErrOut, "skipped code owner @%s: the team of the other organization can not review %s\n", candidate.name, pr.GetHTMLURL())
+				continue
+			}
+			if skip[strings.ToLower(candidate.name)] {
+				requested++
+				continue
+			}
+			candidates = append(candidates, candidate)
+		}
+		if requested > 0 && len(candidates) == 0 {
+			fmt.Fprintf(c.ioStreams.ErrOut, "the code owners of %s are already requested\n", pr.GetHTMLURL())
+			return nil
+		}
+	}
+
+	if len(candidates) == 0 {
+		if team == "" {
+			return errors.New("no code owner matches the changed files, and no fallback team is configured")
+		}
+		if candidates, err = teamCandidates(ctx, client, team, skip, number, c.count); err != nil {
+			return err
+		}
+		if len(candidates) == 0 {
+			return fmt.Errorf("no member of %s team can review %s", team, pr.GetHTMLURL())
+		}
+	}
+
+	if err := writeReviewerCandidates(c.ioStreams.Out, candidates); err != nil {
+		return err
+	}
+	if c.dryRun {
+		return nil
+	}
+
+	names := make([]string, len(candidates))
+	for i, candidate := range candidates {
+		names[i] = candidate.name
+	}
+	if _, _, err := client.PullRequests.RequestReviewers(ctx, owner, repo, number, newReviewersRequest(names)); err != nil {
+		return fmt.Errorf("could not request reviewers of %s: %w", pr.GetHTMLURL(), IsRateLimitError(err))
+	}
+	fmt.Fprintf(c.ioStreams.ErrOut, "requested reviews of %s\n", pr.GetHTMLURL())
+
+	return nil
+}
+
+// getCodeOwners returns the CODEOWNERS file of the ref of owner/repo. It returns nil if the repository has no CODEOWNERS file.
+func getCodeOwners(ctx context.Context, client *github.Client, owner, repo, ref string) (*codeowners.File, error) {
+	for _, path := range codeowners.Paths {
+		content, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, path, &github.RepositoryContentGetOptions{Ref: ref})
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return nil, fmt.Errorf("could not get %s/%s %s: %w", owner, repo, path, IsRateLimitError(err))
+		}
+		text, err := content.GetContent()
+		if err != nil {
+			return nil, fmt.Errorf("could not decode %s/%s %s: %w", owner, repo, path, err)
+		}
+		return codeowners.Parse(strings.NewReader(text))
+	}
+	return nil, nil
+}
+
+// listPullRequestFiles returns the changed file names of the pull request.
+func listPullRequestFiles(ctx context.Context, client *github.Client, owner, repo string, number int) ([]string, error) {
+	var files []string
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		commitFiles, resp, err := client.PullRequests.ListFiles(ctx, owner, repo, number, opts)
+		if err != nil {
+			return nil, fmt.Errorf("could not list %s/%s#%d files: %w", owner, repo, number, IsRateLimitError(err))
+		}
+		for _, file := range commitFiles {
+			files = append(files, file.GetFilename())
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return files, nil
+}
+
+// codeOwnerCandidates returns the code owners of files, in the order of the first owned file.
+// The owners of the email address are skipped since the reviews can not be requested by the email.
+func codeOwnerCandidates(owners *codeowners.File, files []string) []reviewerCandidate {
+	var candidates []reviewerCandidate
+	index := make(map[string]int)
+	count := make(map[string]int)
+	for _, file := range files {
+		for _, owner := range owners.Owners(file) {
+			if !strings.HasPrefix(owner, "@") {
+				continue
+			}
+			name := strings.TrimPrefix(owner, "@")
+			if _, ok := index[name]; !ok {
+				index[name] = len(candidates)
+				candidates = append(candidates, reviewerCandidate{name: name})
+			}
+			count[name]++
+		}
+	}
+	for i := range candidates {
+		candidates[i].reason = fmt.Sprintf("code owner of %d files", count[candidates[i].name])
+	}
+	return candidates
+}
+
+// teamCandidates returns count members of the org/team except skip, which have the fewest open review requests in the org.
+// The ties are broken round-robin by number, so the consecutive pull requests are assigned to the different members.
+func teamCandidates(ctx context.Context, client *github.Client, team string, skip map[string]bool, number, count int) ([]reviewerCandidate, error) {
+	org, slug, err := splitOwnerRepo(team)
+	if err != nil {
+		return nil, fmt.Errorf("invalid team %q: must be org/team", team)
+	}
+
+	var members []string
+	opts := &github.TeamListTeamMembersOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		users, resp, err := client.Teams.ListTeamMembersBySlug(ctx, org, slug, opts)
+		if err != nil {
+			return nil, fmt.Errorf("could not list %s team members: %w", team, IsRateLimitError(err))
+		}
+		for _, user := range users {
+			if login := user.GetLogin(); !skip[strings.ToLower(login)] {
+				members = append(members, login)
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	if len(members) == 0 {
+		return nil, nil
+	}
+	sort.Strings(members)
+
+	requests, err := reviewRequestCounts(ctx, client, org, members)
+	if err != nil {
+		return nil, err
+	}
+	loads := make([]int, len(members))
+	for i, member := range members {
+		loads[i] = requests[strings.ToLower(member)]
+	}
+
+	// rotation is the round-robin order of member i for the pull request number
+	n := len(members)
+	rotation := func(i int) int { return (i - number%n + n) % n }
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool {
+		i, j := order[a], order[b]
+		if loads[i] != loads[j] {
+			return loads[i] < loads[j]
+		}
+		return rotation(i) < rotation(j)
+	})
+
+	if count > n {
+		count = n
+	}
+	candidates := make([]reviewerCandidate, count)
+	for k := 0; k < count; k++ {
+		i := order[k]
+		candidates[k] = reviewerCandidate{
+			name:   members[i],
+			reason: fmt.Sprintf("%s member with %d open review requests", team, loads[i]),
+		}
+	}
+
+	return candidates, nil
+}
+
+// reviewRequestBatch is the number of the users whose review requests are counted in a GraphQL request.
+const reviewRequestBatch = 50
+
+// reviewRequestCounts returns the number of the open pull requests in the org which request the review of each user,
+// keyed by the lower-cased login. The users are counted by the issueCount of the search in the GraphQL request
+// per reviewRequestBatch users, which is exact without paging through the pull requests.
+func reviewRequestCounts(ctx context.Context, client *github.Client, org string, users []string) (map[string]int, error) {
+	counts := make(map[string]int)
+	for start := 0; start < len(users); start += reviewRequestBatch {
+		end := start + reviewRequestBatch
+		if end > len(users) {
+			end = len(users)
+		}
+		batch := users[start:end]
+
+		var params, fields strings.Builder
+		variables := make(map[string]interface{}, len(batch))
+		for i, user := range batch {
+			fmt.Fprintf(&params, "$q%d: String!, ", i)
+			fmt.Fprintf(&fields, "  u%d: search(query: $q%d, type: ISSUE, first: 1) { issueCount }\n", i, i)
+			variables[fmt.Sprintf("q%d", i)] = fmt.Sprintf("org:%s is:pr is:open user-review-requested:%s", org, user)
+		}
+		query := fmt.Sprintf("query(%s) {\n%s}", strings.TrimSuffix(params.String(), ", "), fields.String())
+
+		var data map[string]struct {
+			IssueCount int `json:"issueCount"`
+		}
+		if err := doGraphQL(ctx, client, query, variables, &data); err != nil {
+			return nil, fmt.Errorf("could not count review requests in %s: %w", org, err)
+		}
+		for i, user := range batch {
+			counts[strings.ToLower(user)] = data[fmt.Sprintf("u%d", i)].IssueCount
+		}
+	}
+
+	return counts, nil
+}
+
+func writeReviewerCandidates(w io.Writer, candidates []reviewerCandidate) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	for _, candidate := range candidates {
+		fmt.Fprintf(tw, "%s\t%s\n", candidate.name, candidate.reason)
+	}
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("could not flush tabwriter: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,321 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/go-github/v38/github"
+	"github.com/spf13/cobra"
+
+	"github.com/zchee/ghctl/pkg/spin"
+)
+
+type pullRequestBulkCmd struct {
+	ioStreams *IOStreams
+
+	query        string
+	close        bool
+	addLabels    []string
+	removeLabels []string
+	comment      string
+	yes          bool
+}
+
+func init() {
+	prCmd.AddCommand(newCmdPullRequestBulk())
+}
+
+func newCmdPullRequestBulk() *cobra.Command {
+	c := &pullRequestBulkCmd{
+		ioStreams: defaultIOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "bulk --query <search> [--close] [--add-label <label>] [--remove-label <label>] [--comment <body>]",
+		Short: "Applies the operations to the all pull requests which match the search query",
+		Long: `Applies the operations to the all pull requests which match the search query.
+
+The query is the GitHub search syntax, such as 'author:app/dependabot repo:owner/repo is:open'.
+The matched pull requests are previewed and asked for the confirmation before the operations are applied.
+The operations are applied in the order of comment, add-label, remove-label and close.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkArgs(cmd, args, 0, exactArgs); err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			return c.runBulk(ctx)
+		},
+		Annotations: noPagerAnnotation(),
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(&c.query, "query", "q", "", "search query of the pull requests (required)")
+	f.BoolVar(&c.close, "close", false, "close the pull requests")
+	f.StringSliceVar(&c.addLabels, "add-label", nil, "add the labels to the pull requests")
+	f.StringSliceVar(&c.removeLabels, "remove-label", nil, "remove the labels from the pull requests")
+	f.StringVar(&c.comment, "comment", "", "comment the body on the pull requests")
+	f.BoolVarP(&c.yes, "yes", "y", false, "apply without the confirmation")
+
+	return cmd
+}
+
+// bulkResult represents the result of the operations on the pull request.
+type bulkResult struct {
+	item pullRequestListItem
+	err  error
+}
+
+func (c *pullRequestBulkCmd) runBulk(ctx context.Context) error {
+	if c.query == "" {
+		return errors.New("--query flag must be not empty")
+	}
+	operations := c.operations()
+	if len(operations) == 0 {
+		return errors.New("specify any of --close, --add-label, --remove-label or --comment flags")
+	}
+
+	client := newClient(ctx)
+
+	progress := spin.NewProgress(c.ioStreams.ErrOut)
+	progress.AddTask("searching pull requests", 0)
+	progress.Start()
+	items, err := searchPullRequestsQuery(ctx, client, c.query)
+	progress.Stop()
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		fmt.Fprintln(c.ioStreams.ErrOut, "no pull requests matched")
+		return nil
+	}
+
+	if err := writeBulkPreview(c.ioStreams.Out, items); err != nil {
+		return err
+	}
+	if !c.yes {
+		if !canPrompt(c.ioStreams) {
+			return errors.New("confirmation is required, use --yes flag in the non-interactive mode")
+		}
+		ok, err := confirm(c.ioStreams, fmt.Sprintf("%s %d pull requests?", strings.Join(operations, ", "), len(items)))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.New("cancelled")
+		}
+	}
+
+	results := c.apply(ctx, client, items)
+
+	failed := 0
+	for _, result := range results {
+		name := fmt.Sprintf("%s/%s#%d", result.item.Owner, result.item.Repo, result.item.Number)
+		if result.err != nil {
+			failed++
+			fmt.Fprintf(c.ioStreams.Out, "X %s: %v\n", name, result.err)
+			continue
+		}
+		fmt.Fprintf(c.ioStreams.Out, "✓ %s\n", name)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d pull requests failed", failed, len(results))
+	}
+
+	return nil
+}
+
+// operations returns the human readable names of the operations of the flags.
+func (c *pullRequestBulkCmd) operations() []string {
+	var operations []string
+	if c.comment != "" {
+		operations = append(operations, "comment on")
+	}
+	if len(c.addLabels) > 0 {
+		operations = append(operations, "add "+strings.Join(c.addLabels, ", ")+" labels to")
+	}
+	if len(c.removeLabels) > 0 {
+		operations = append(operations, "remove "+strings.Join(c.removeLabels, ", ")+" labels from")
+	}
+	if c.close {
+		operations = append(operations, "close")
+	}
+	return operations
+}
+
+// apply applies the operations to items sequentially, and returns the results in the order of items.
+// The failure of an item does not stop the others.
+func (c *pullRequestBulkCmd) apply(ctx context.Context, client *github.Client, items []pullRequestListItem) []bulkResult {
+	progress := spin.NewProgress(c.ioStreams.ErrOut)
+	task := progress.AddTask("applying", len(items))
+	progress.Start()
+	defer progress.Stop()
+
+	// the write requests are not concurrent, since the concurrent content creation hits the secondary rate limit
+	var pacer writePacer
+	results := make([]bulkResult, len(items))
+	for i, item := range items {
+		results[i] = bulkResult{item: item, err: c.applyOne(ctx, client, &pacer, item)}
+		task.Increment()
+	}
+
+	return results
+}
+
+// applyOne applies the operations to the item.
+func (c *pullRequestBulkCmd) applyOne(ctx context.Context, client *github.Client, pacer *writePacer, item pullRequestListItem) error {
+	owner, repo, number := item.Owner, item.Repo, item.Number
+
+	if c.comment != "" {
+		if err := pacer.do(ctx, func() error {
+			_, _, err := client.Issues.CreateComment(ctx, owner, repo, number, &github.IssueComment{Body: github.String(c.comment)})
+			return err
+		}); err != nil {
+			return fmt.Errorf("could not comment: %w", IsRateLimitError(err))
+		}
+	}
+	if len(c.addLabels) > 0 {
+		if err := pacer.do(ctx, func() error {
+			_, _, err := client.Issues.AddLabelsToIssue(ctx, owner, repo, number, c.addLabels)
+			return err
+		}); err != nil {
+			return fmt.Errorf("could not add labels: %w", IsRateLimitError(err))
+		}
+	}
+	for _, label := range c.removeLabels {
+		if err := pacer.do(ctx, func() error {
+			resp, err := client.Issues.RemoveLabelForIssue(ctx, owner, repo, number, label)
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil // the pull request does not have the label
+			}
+			return err
+		}); err != nil {
+			return fmt.Errorf("could not remove %s label: %w", label, IsRateLimitError(err))
+		}
+	}
+	if c.close {
+		if err := pacer.do(ctx, func() error {
+			_, _, err := client.PullRequests.Edit(ctx, owner, repo, number, &github.PullRequest{State: github.String("closed")})
+			return err
+		}); err != nil {
+			return fmt.Errorf("could not close: %w", IsRateLimitError(err))
+		}
+	}
+
+	return nil
+}
+
+// writeInterval is the interval of the paced write requests, which GitHub recommends for the content creation
+// to avoid the secondary rate limit.
+const writeInterval = time.Second
+
+// writeRetries is the maximum number of the retries of the write request which hits the secondary rate limit.
+const writeRetries = 3
+
+// writePacer paces the sequential write requests by writeInterval, and retries the request which hits the secondary rate limit.
+type writePacer struct {
+	last time.Time
+}
+
+// do calls fn after the interval from the previous call. If fn hits the secondary rate limit,
+// do waits for Retry-After, or a minute if it is not given, and calls fn again.
+func (p *writePacer) do(ctx context.Context, fn func() error) error {
+	for retry := 0; ; retry++ {
+		if d := writeInterval - time.Since(p.last); !p.last.IsZero() && d > 0 {
+			if err := sleepContext(ctx, d); err != nil {
+				return err
+			}
+		}
+		err := fn()
+		p.last = time.Now()
+
+		var abuseErr *github.AbuseRateLimitError
+		if !errors.As(err, &abuseErr) || retry == writeRetries {
+			return err
+		}
+		wait := abuseErr.GetRetryAfter()
+		if wait <= 0 {
+			wait = time.Minute
+		}
+		if err := sleepContext(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// sleepContext sleeps for d, or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// searchPullRequestsQuery searches the pull requests by the raw search query.
+// The type:pr qualifier is added if the query does not have it.
+// It fails if the search result is incomplete, such as over 1000 results which the search API returns at most,
+// since the bulk operation should not apply to the part of the matched pull requests.
+func searchPullRequestsQuery(ctx context.Context, client *github.Client, query string) ([]pullRequestListItem, error) {
+	if !strings.Contains(query, "type:pr") && !strings.Contains(query, "is:pr") {
+		query = "type:pr " + query
+	}
+
+	opts := &github.SearchOptions{
+		ListOptions: github.ListOptions{
+			PerPage: 100,
+		},
+	}
+	var items []pullRequestListItem
+	total := 0
+	for {
+		result, resp, err := client.Search.Issues(ctx, query, opts)
+		if err != nil {
+			return nil, fmt.Errorf("could not get search pull request result: %w", IsRateLimitError(err))
+		}
+		if result.GetIncompleteResults() {
+			return nil, errors.New("search result is incomplete due to the timeout, retry or narrow down the query")
+		}
+		total = result.GetTotal()
+		for _, issue := range result.Issues {
+			items = append(items, newPullRequestListItem(issue, pullRequestState(issue.GetState())))
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	if total > len(items) {
+		return nil, fmt.Errorf("query matched %d pull requests, but the search API returned only %d: narrow down the query", total, len(items))
+	}
+
+	return items, nil
+}
+
+// writeBulkPreview writes the list of items to be applied.
+func writeBulkPreview(w io.Writer, items []pullRequestListItem) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	for _, item := range items {
+		fmt.Fprintf(tw, "%s/%s#%d\t%s\t%s\n", item.Owner, item.Repo, item.Number, item.State, item.Title)
+	}
+	fmt.Fprintf(tw, "\n%d pull requests matched\n", len(items))
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("could not flush tabwriter: %w", err)
+	}
+	return nil
+}
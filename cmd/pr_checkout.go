@@ -0,0 +1,258 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v38/github"
+	"github.com/spf13/cobra"
+
+	"github.com/zchee/ghctl/pkg/git"
+)
+
+type pullRequestCheckoutCmd struct {
+	ioStreams *IOStreams
+
+	repo   string
+	branch string
+	detach bool
+}
+
+func init() {
+	prCmd.AddCommand(newCmdPullRequestCheckout())
+}
+
+func newCmdPullRequestCheckout() *cobra.Command {
+	c := &pullRequestCheckoutCmd{
+		ioStreams: defaultIOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "checkout <number>",
+		Short: "Checks out the pull request into the local git repository",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkArgs(cmd, args, 1, exactArgs, "<number>"); err != nil {
+				return err
+			}
+			number, err := parsePullRequestNumber(args[0])
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			return c.runCheckout(ctx, number)
+		},
+		Annotations: noPagerAnnotation(),
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(&c.repo, "repo", "R", "", "repository of the pull request as owner/repo (default: upstream remote, or origin)")
+	f.StringVarP(&c.branch, "branch", "b", "", "local branch name (default: head branch name of the pull request)")
+	f.BoolVar(&c.detach, "detach", false, "check out the pull request with detached HEAD for read-only review")
+
+	return cmd
+}
+
+func (c *pullRequestCheckoutCmd) runCheckout(ctx context.Context, number int) error {
+	if c.detach && c.branch != "" {
+		return errors.New("--branch and --detach flags are mutually exclusive")
+	}
+
+	remotes, err := git.Remotes(ctx)
+	if err != nil {
+		return err
+	}
+	baseRemote, owner, repo, err := c.baseRepository(remotes)
+	if err != nil {
+		return err
+	}
+
+	client := newClient(ctx)
+
+	pr, _, err := client.PullRequests.Get(ctx, owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("could not get %s/%s#%d pull request: %w", owner, repo, number, IsRateLimitError(err))
+	}
+
+	// fetch from the remote name if exists so the remote-tracking configs apply, otherwise from the URL
+	fetchFrom := "https://github.com/" + owner + "/" + repo + ".git"
+	if baseRemote != nil {
+		fetchFrom = baseRemote.Name
+	}
+	pullRef := fmt.Sprintf("refs/pull/%d/head", number)
+
+	if c.detach {
+		if err := git.Fetch(ctx, fetchFrom, pullRef); err != nil {
+			return err
+		}
+		if err := git.Checkout(ctx, "--detach", "FETCH_HEAD"); err != nil {
+			return err
+		}
+		fmt.Fprintf(c.ioStreams.ErrOut, "checked out %s/%s#%d at detached HEAD\n", owner, repo, number)
+		return nil
+	}
+
+	branch := c.branch
+	if branch == "" {
+		if branch, err = checkoutBranchName(ctx, pr, remotes); err != nil {
+			return err
+		}
+	} else if git.HasRef(ctx, "refs/heads/"+branch) && !branchOwnedBy(ctx, pr, branch, remotes) {
+		return fmt.Errorf("%s branch already exists and does not track %s/%s#%d", branch, owner, repo, number)
+	}
+
+	current, err := git.CurrentBranch(ctx)
+	if err != nil && !errors.Is(err, git.ErrNotOnBranch) {
+		return err
+	}
+	// the branch is new or owned by the pull request here, so it follows the force-pushed head of the pull request
+	if current == branch {
+		// git fetch refuses to update the checked out branch
+		if err := git.Fetch(ctx, fetchFrom, pullRef); err != nil {
+			return err
+		}
+		if err := c.updateCurrentBranch(ctx, branch); err != nil {
+			return err
+		}
+	} else {
+		if err := git.Fetch(ctx, fetchFrom, "+"+pullRef+":refs/heads/"+branch); err != nil {
+			return err
+		}
+		if err := git.Checkout(ctx, branch); err != nil {
+			return err
+		}
+	}
+
+	if err := setCheckoutTracking(ctx, pr, branch, fetchFrom, baseRemote); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.ioStreams.ErrOut, "checked out %s/%s#%d to %s\n", owner, repo, number, branch)
+
+	return nil
+}
+
+// updateCurrentBranch updates the checked out branch to FETCH_HEAD. It fast-forwards if possible, otherwise
+// the head of the pull request is force-pushed, and the branch is reset to it if the working tree is clean.
+func (c *pullRequestCheckoutCmd) updateCurrentBranch(ctx context.Context, branch string) error {
+	if git.IsAncestor(ctx, "HEAD", "FETCH_HEAD") {
+		return git.MergeFastForward(ctx, "FETCH_HEAD")
+	}
+
+	clean, err := git.IsClean(ctx)
+	if err != nil {
+		return err
+	}
+	if !clean {
+		return fmt.Errorf("the pull request was force-pushed, and %s branch has the local changes: commit or stash them and retry", branch)
+	}
+	head, err := git.RevParse(ctx, "HEAD")
+	if err != nil {
+		return err
+	}
+	if err := git.ResetHard(ctx, "FETCH_HEAD"); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.ioStreams.ErrOut, "reset %s branch to the force-pushed head, the previous head was %.7s\n", branch, head)
+
+	return nil
+}
+
+// baseRepository returns the remote and the owner and name of the repository which has the pull request.
+// The returned remote is nil if no remote points to the repository of the --repo flag.
+func (c *pullRequestCheckoutCmd) baseRepository(remotes []*git.Remote) (remote *git.Remote, owner, repo string, err error) {
+	if c.repo != "" {
+		owner, repo, err := splitOwnerRepo(c.repo)
+		if err != nil {
+			return nil, "", "", err
+		}
+		for _, r := range remotes {
+			if strings.EqualFold(r.Owner, owner) && strings.EqualFold(r.Repo, repo) {
+				return r, owner, repo, nil
+			}
+		}
+		return nil, owner, repo, nil
+	}
+
+	if len(remotes) == 0 {
+		return nil, "", "", errors.New("not found any github.com remote in the git repository")
+	}
+	// remotes are sorted by upstream, github and origin
+	return remotes[0], remotes[0].Owner, remotes[0].Repo, nil
+}
+
+// checkoutBranchName returns the local branch name of pr.
+// It uses the head branch name, or prefixes the head owner if the branch already exists and the pull request
+// does not own it, such as the fork pull request from main. The branch which the pull request does not own
+// is never reused, since the fetch would move it to the pull request commits and retarget its upstream.
+func checkoutBranchName(ctx context.Context, pr *github.PullRequest, remotes []*git.Remote) (string, error) {
+	number := pr.GetNumber()
+	name := pr.GetHead().GetRef()
+	candidates := []string{fmt.Sprintf("pr-%d", number)}
+	if name != "" {
+		candidates = []string{name, pr.GetHead().GetUser().GetLogin() + "-" + name, fmt.Sprintf("pr-%d-%s", number, name)}
+	}
+
+	for _, candidate := range candidates {
+		if !git.HasRef(ctx, "refs/heads/"+candidate) || branchOwnedBy(ctx, pr, candidate, remotes) {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("branches %s already exist and do not track #%d, use --branch flag", strings.Join(candidates, ", "), number)
+}
+
+// branchOwnedBy reports whether the local branch tracks the head branch or the pull request ref of pr.
+func branchOwnedBy(ctx context.Context, pr *github.PullRequest, branch string, remotes []*git.Remote) bool {
+	merge := git.Config(ctx, "branch."+branch+".merge")
+	if merge == fmt.Sprintf("refs/pull/%d/head", pr.GetNumber()) {
+		return true
+	}
+
+	head := pr.GetHead()
+	headRepo := head.GetRepo()
+	if headRepo == nil || merge != "refs/heads/"+head.GetRef() {
+		return false
+	}
+
+	remote := git.Config(ctx, "branch."+branch+".remote")
+	if remote == headRepo.GetCloneURL() || remote == headRepo.GetSSHURL() {
+		return true
+	}
+	r := git.FindRemote(remotes, remote)
+	return r != nil && strings.EqualFold(r.Owner, headRepo.GetOwner().GetLogin()) && strings.EqualFold(r.Repo, headRepo.GetName())
+}
+
+// setCheckoutTracking configures the upstream of the local branch.
+//
+// If the maintainers can modify the head branch of the fork, or the head branch is in the base repository,
+// the branch tracks the head branch so that git pull and git push work. Otherwise it tracks the pull request ref.
+func setCheckoutTracking(ctx context.Context, pr *github.PullRequest, branch, fetchFrom string, remote *git.Remote) error {
+	head := pr.GetHead()
+	headRepo := head.GetRepo()
+	sameRepo := headRepo != nil && headRepo.GetID() == pr.GetBase().GetRepo().GetID()
+
+	trackRemote, trackMerge := fetchFrom, fmt.Sprintf("refs/pull/%d/head", pr.GetNumber())
+	switch {
+	case sameRepo:
+		trackMerge = "refs/heads/" + head.GetRef()
+	case headRepo != nil && pr.GetMaintainerCanModify():
+		trackRemote = headRepo.GetCloneURL()
+		if remote != nil && remote.IsSSH() {
+			trackRemote = headRepo.GetSSHURL()
+		}
+		trackMerge = "refs/heads/" + head.GetRef()
+	}
+
+	if err := git.SetConfig(ctx, "branch."+branch+".remote", trackRemote); err != nil {
+		return err
+	}
+	return git.SetConfig(ctx, "branch."+branch+".merge", trackMerge)
+}
@@ -0,0 +1,302 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/go-github/v38/github"
+	"github.com/spf13/cobra"
+	color "github.com/zchee/color/v2"
+
+	"github.com/zchee/ghctl/pkg/spin"
+)
+
+// githubActionsApp is the slug of the GitHub Actions app which creates the check runs of the workflow jobs.
+const githubActionsApp = "github-actions"
+
+type pullRequestChecksCmd struct {
+	ioStreams *IOStreams
+
+	watch    bool
+	interval time.Duration
+	timeout  time.Duration
+	logTail  int
+
+	mu     sync.Mutex
+	checks []checkResult
+}
+
+func init() {
+	prCmd.AddCommand(newCmdPullRequestChecks())
+}
+
+func newCmdPullRequestChecks() *cobra.Command {
+	c := &pullRequestChecksCmd{
+		ioStreams: defaultIOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "checks <owner/repo> <number>",
+		Short: "Shows the check runs and commit statuses of the pull request. exits non-zero if any check fails",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkArgs(cmd, args, 2, exactArgs, "<owner/repo> <number>"); err != nil {
+				return err
+			}
+			owner, repo, number, err := parseRepositoryNumber(args)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			return c.runChecks(ctx, owner, repo, number)
+		},
+		Annotations:       noPagerAnnotation(),
+		ValidArgsFunction: completeRepositoryPullRequest,
+	}
+
+	f := cmd.Flags()
+	f.BoolVarP(&c.watch, "watch", "w", false, "watch the checks until the all checks complete")
+	f.DurationVar(&c.interval, "interval", 10*time.Second, "polling interval of the checks with --watch")
+	f.DurationVar(&c.timeout, "timeout", time.Hour, "maximum time to watch the checks with --watch. zero means no limit")
+	f.IntVar(&c.logTail, "log-tail", 0, "print the last N lines of the log of the failed GitHub Actions jobs")
+
+	return cmd
+}
+
+func (c *pullRequestChecksCmd) runChecks(ctx context.Context, owner, repo string, number int) error {
+	client := newClient(ctx)
+
+	pr, _, err := client.PullRequests.Get(ctx, owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("could not get %s/%s#%d pull request: %w", owner, repo, number, IsRateLimitError(err))
+	}
+	sha := pr.GetHead().GetSHA()
+
+	if err := c.update(ctx, client, owner, repo, sha); err != nil {
+		return err
+	}
+
+	var watchErr error
+	if c.watch {
+		watchErr = c.watchChecks(ctx, client, owner, repo, sha)
+	}
+	if _, err := io.WriteString(c.ioStreams.Out, strings.Join(c.render(""), "\n")+"\n"); err != nil {
+		return err
+	}
+	if watchErr != nil {
+		return watchErr
+	}
+
+	c.mu.Lock()
+	checks := c.checks
+	c.mu.Unlock()
+
+	state, failed := summarizeChecks(checks)
+	switch state {
+	case checkFailure:
+		if c.logTail > 0 {
+			c.writeFailedLogs(ctx, client, owner, repo, checks)
+		}
+		return fmt.Errorf("%d checks failed: %s", len(failed), strings.Join(failed, ", "))
+	case checkPending:
+		if len(checks) == 0 {
+			return errors.New("no checks reported")
+		}
+		return errors.New("some checks are still pending")
+	}
+
+	return nil
+}
+
+// watchChecks polls the checks of sha until no check is pending, even if some checks already failed.
+// The live table is drawn on the ErrOut only if it is the terminal, so the redirected Out gets the final table only.
+func (c *pullRequestChecksCmd) watchChecks(ctx context.Context, client *github.Client, owner, repo, sha string) error {
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	committed, err := headCommitTime(ctx, client, owner, repo, sha)
+	if err != nil {
+		return err
+	}
+
+	if c.ioStreams.IsStderrTTY() {
+		table := spin.NewTable(c.ioStreams.ErrOut, c.render)
+		table.Start()
+		defer table.Stop()
+	}
+
+	for c.pending(committed) {
+		select {
+		case <-ctx.Done():
+		case <-time.After(c.interval):
+			err = c.update(ctx, client, owner, repo, sha)
+		}
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("timed out watching the checks of %s/%s@%.7s", owner, repo, sha)
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// update fetches the checks of sha.
+func (c *pullRequestChecksCmd) update(ctx context.Context, client *github.Client, owner, repo, sha string) error {
+	checks, err := listChecks(ctx, client, owner, repo, sha)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.checks = checks
+	c.mu.Unlock()
+
+	return nil
+}
+
+// pending reports whether any current check is pending. No checks are pending
+// within noChecksGrace after committed, since the CI may not register the checks yet.
+func (c *pullRequestChecksCmd) pending(committed time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.checks) == 0 {
+		return time.Since(committed) < noChecksGrace
+	}
+	for _, check := range c.checks {
+		if check.State == checkPending {
+			return true
+		}
+	}
+	return false
+}
+
+// render returns the table lines of the current checks. The pending checks show frame if not empty.
+func (c *pullRequestChecksCmd) render(frame string) []string {
+	c.mu.Lock()
+	checks := c.checks
+	c.mu.Unlock()
+
+	if len(checks) == 0 {
+		return []string{"no checks reported yet"}
+	}
+
+	now := time.Now()
+	var buf bytes.Buffer
+	tw := tabwriter.NewWriter(&buf, 0, 8, 2, ' ', 0)
+	for _, check := range checks {
+		elapsed := "-"
+		if d := check.Elapsed(now); d > 0 {
+			elapsed = d.Round(time.Second).String()
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", check.Name, elapsed, check.URL)
+	}
+	tw.Flush()
+
+	// the symbols are prefixed after the alignment, since the color escape sequences break the tabwriter widths
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	for i, check := range checks {
+		lines[i] = checkSymbol(check.State, frame) + " " + lines[i]
+	}
+
+	state, failed := summarizeChecks(checks)
+	pending := 0
+	for _, check := range checks {
+		if check.State == checkPending {
+			pending++
+		}
+	}
+	lines = append(lines, "", fmt.Sprintf("%s: %d checks, %d failed, %d pending", state, len(checks), len(failed), pending))
+
+	return lines
+}
+
+// checkSymbol returns the symbol of state. The pending state is frame if not empty.
+func checkSymbol(state checkState, frame string) string {
+	switch state {
+	case checkSuccess:
+		return color.GreenString("✓")
+	case checkFailure:
+		return color.RedString("X")
+	case checkSkipped:
+		return "-"
+	default:
+		if frame != "" {
+			return color.YellowString(frame)
+		}
+		return color.YellowString("*")
+	}
+}
+
+// writeFailedLogs writes the last c.logTail lines of the logs of the failed GitHub Actions jobs.
+// The log of the other check runs and commit statuses are not available via the API, so skipped.
+func (c *pullRequestChecksCmd) writeFailedLogs(ctx context.Context, client *github.Client, owner, repo string, checks []checkResult) {
+	for _, check := range checks {
+		if check.State != checkFailure || check.checkRunID == 0 || check.app != githubActionsApp {
+			continue
+		}
+
+		fmt.Fprintf(c.ioStreams.Out, "\n==> %s <==\n", check.Name)
+		tail, err := jobLogTail(ctx, client, owner, repo, check.checkRunID, c.logTail)
+		if err != nil {
+			fmt.Fprintf(c.ioStreams.ErrOut, "could not get the log of %s: %v\n", check.Name, err)
+			continue
+		}
+		fmt.Fprintln(c.ioStreams.Out, tail)
+	}
+}
+
+// jobLogTail returns the last n lines of the log of the GitHub Actions job.
+// The ID of the job is same as the check run ID.
+func jobLogTail(ctx context.Context, client *github.Client, owner, repo string, jobID int64, n int) (string, error) {
+	u, _, err := client.Actions.GetWorkflowJobLogs(ctx, owner, repo, jobID, true)
+	if err != nil {
+		return "", IsRateLimitError(err)
+	}
+
+	// the log URL is the pre-signed URL, which must not have the authorization header
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	lines := strings.Split(strings.TrimRight(string(data), "\r\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n"), nil
+}
@@ -0,0 +1,331 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/go-github/v38/github"
+	"github.com/spf13/cobra"
+
+	"github.com/zchee/ghctl/pkg/git"
+)
+
+// pullRequestTemplates is the candidate paths of the pull request template relative to the repository top-level.
+var pullRequestTemplates = []string{
+	".github/pull_request_template.md",
+	".github/PULL_REQUEST_TEMPLATE.md",
+	"pull_request_template.md",
+	"PULL_REQUEST_TEMPLATE.md",
+	"docs/pull_request_template.md",
+	"docs/PULL_REQUEST_TEMPLATE.md",
+}
+
+type pullRequestCreateCmd struct {
+	ioStreams *IOStreams
+
+	title     string
+	body      string
+	bodyFile  string
+	base      string
+	repo      string
+	remote    string
+	draft     bool
+	noPush    bool
+	reviewers []string
+	labels    []string
+}
+
+func init() {
+	prCmd.AddCommand(newCmdPullRequestCreate())
+}
+
+func newCmdPullRequestCreate() *cobra.Command {
+	c := &pullRequestCreateCmd{
+		ioStreams: defaultIOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Creates the pull request from the current branch of the local git repository",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkArgs(cmd, args, 0, exactArgs); err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			return c.runCreate(ctx)
+		},
+		Annotations: noPagerAnnotation(),
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(&c.title, "title", "t", "", "title of the pull request (default: from commits)")
+	f.StringVarP(&c.body, "body", "b", "", "body of the pull request (default: from the pull request template or commits)")
+	f.StringVarP(&c.bodyFile, "body-file", "F", "", "read the body of the pull request from the file. \"-\" reads from stdin")
+	f.StringVarP(&c.base, "base", "B", "", "base branch of the pull request (default: default branch of the base repository)")
+	f.StringVarP(&c.repo, "repo", "R", "", "base repository of the pull request as owner/repo (default: upstream remote, or parent of the fork)")
+	f.StringVar(&c.remote, "remote", "", "remote to push the current branch (default: push remote of the branch, or origin)")
+	f.BoolVarP(&c.draft, "draft", "d", false, "create the pull request as draft")
+	f.BoolVar(&c.noPush, "no-push", false, "do not push the current branch")
+	f.StringSliceVarP(&c.reviewers, "reviewer", "r", nil, "request reviews from the users or teams (org/team)")
+	f.StringSliceVarP(&c.labels, "label", "l", nil, "add the labels to the pull request")
+
+	return cmd
+}
+
+func (c *pullRequestCreateCmd) runCreate(ctx context.Context) error {
+	if c.body != "" && c.bodyFile != "" {
+		return errors.New("--body and --body-file flags are mutually exclusive")
+	}
+
+	branch, err := git.CurrentBranch(ctx)
+	if err != nil {
+		return err
+	}
+	remotes, err := git.Remotes(ctx)
+	if err != nil {
+		return err
+	}
+	if len(remotes) == 0 {
+		return errors.New("not found any github.com remote in the git repository")
+	}
+
+	headRemote, err := c.headRemote(ctx, branch, remotes)
+	if err != nil {
+		return err
+	}
+
+	client := newClient(ctx)
+
+	baseOwner, baseRepo, err := c.baseRepository(ctx, client, headRemote, remotes)
+	if err != nil {
+		return err
+	}
+	base := c.base
+	if base == "" {
+		repository, _, err := client.Repositories.Get(ctx, baseOwner, baseRepo)
+		if err != nil {
+			return fmt.Errorf("could not get %s/%s repository: %w", baseOwner, baseRepo, IsRateLimitError(err))
+		}
+		base = repository.GetDefaultBranch()
+	}
+
+	// cross-fork pull request needs the owner prefix to the head
+	head := branch
+	if !strings.EqualFold(headRemote.Owner, baseOwner) {
+		head = headRemote.Owner + ":" + branch
+	}
+
+	if !c.noPush {
+		if err := c.pushIfNeeded(ctx, headRemote, branch); err != nil {
+			return err
+		}
+	}
+
+	title, body, err := c.titleAndBody(ctx, remotes, baseOwner, baseRepo, base, branch)
+	if err != nil {
+		return err
+	}
+
+	pr, _, err := client.PullRequests.Create(ctx, baseOwner, baseRepo, &github.NewPullRequest{
+		Title:               github.String(title),
+		Head:                github.String(head),
+		Base:                github.String(base),
+		Body:                github.String(body),
+		Draft:               github.Bool(c.draft),
+		MaintainerCanModify: github.Bool(true),
+	})
+	if err != nil {
+		return fmt.Errorf("could not create pull request %s into %s/%s:%s: %w", head, baseOwner, baseRepo, base, IsRateLimitError(err))
+	}
+
+	if len(c.reviewers) > 0 {
+		if _, _, err := client.PullRequests.RequestReviewers(ctx, baseOwner, baseRepo, pr.GetNumber(), newReviewersRequest(c.reviewers)); err != nil {
+			return fmt.Errorf("created %s, but could not request reviewers: %w", pr.GetHTMLURL(), IsRateLimitError(err))
+		}
+	}
+	if len(c.labels) > 0 {
+		if _, _, err := client.Issues.AddLabelsToIssue(ctx, baseOwner, baseRepo, pr.GetNumber(), c.labels); err != nil {
+			return fmt.Errorf("created %s, but could not add labels: %w", pr.GetHTMLURL(), IsRateLimitError(err))
+		}
+	}
+
+	fmt.Fprintln(c.ioStreams.Out, pr.GetHTMLURL())
+
+	return nil
+}
+
+// headRemote returns the remote to push the branch.
+func (c *pullRequestCreateCmd) headRemote(ctx context.Context, branch string, remotes []*git.Remote) (*git.Remote, error) {
+	candidates := []string{
+		c.remote,
+		git.Config(ctx, "branch."+branch+".pushRemote"),
+		git.Config(ctx, "remote.pushDefault"),
+		git.Config(ctx, "branch."+branch+".remote"),
+		"origin",
+	}
+	for _, name := range candidates {
+		if name == "" {
+			continue
+		}
+		if remote := git.FindRemote(remotes, name); remote != nil {
+			return remote, nil
+		}
+		if name == c.remote {
+			return nil, fmt.Errorf("not found %s github.com remote", name)
+		}
+	}
+
+	return remotes[len(remotes)-1], nil
+}
+
+// baseRepository returns the owner and name of the repository which the pull request is created.
+func (c *pullRequestCreateCmd) baseRepository(ctx context.Context, client *github.Client, headRemote *git.Remote, remotes []*git.Remote) (owner, repo string, err error) {
+	if c.repo != "" {
+		return splitOwnerRepo(c.repo)
+	}
+	if upstream := git.FindRemote(remotes, "upstream"); upstream != nil && upstream != headRemote {
+		return upstream.Owner, upstream.Repo, nil
+	}
+
+	repository, _, err := client.Repositories.Get(ctx, headRemote.Owner, headRemote.Repo)
+	if err != nil {
+		return "", "", fmt.Errorf("could not get %s repository: %w", headRemote.FullName(), IsRateLimitError(err))
+	}
+	if repository.GetFork() && repository.GetParent() != nil {
+		parent := repository.GetParent()
+		return parent.GetOwner().GetLogin(), parent.GetName(), nil
+	}
+
+	return headRemote.Owner, headRemote.Repo, nil
+}
+
+// pushIfNeeded pushes the branch to the remote if the remote branch is not same as HEAD.
+func (c *pullRequestCreateCmd) pushIfNeeded(ctx context.Context, remote *git.Remote, branch string) error {
+	head, err := git.RevParse(ctx, "HEAD")
+	if err != nil {
+		return err
+	}
+	if remoteHead, err := git.RevParse(ctx, "refs/remotes/"+remote.Name+"/"+branch); err == nil && remoteHead == head {
+		return nil
+	}
+
+	fmt.Fprintf(c.ioStreams.ErrOut, "pushing %s to %s\n", branch, remote.Name)
+	return git.Push(ctx, remote.Name, branch)
+}
+
+// titleAndBody returns the title and body of the pull request from the flags, pull request template or commits.
+func (c *pullRequestCreateCmd) titleAndBody(ctx context.Context, remotes []*git.Remote, baseOwner, baseRepo, base, branch string) (title, body string, err error) {
+	title, body = c.title, c.body
+	if c.bodyFile != "" {
+		if body, err = readBodyFile(c.bodyFile, c.ioStreams.In); err != nil {
+			return "", "", err
+		}
+	}
+
+	var commits []git.Commit
+	if baseRef := baseRemoteRef(ctx, remotes, baseOwner, baseRepo, base); baseRef != "" {
+		if commits, err = git.Commits(ctx, baseRef, "HEAD"); err != nil {
+			return "", "", err
+		}
+	}
+
+	if title == "" {
+		switch len(commits) {
+		case 1:
+			title = commits[0].Subject
+		default:
+			title = humanizeBranch(branch)
+		}
+	}
+
+	if body == "" && c.bodyFile == "" {
+		if tmpl := findPullRequestTemplate(ctx); tmpl != "" {
+			body = tmpl
+		} else {
+			switch len(commits) {
+			case 0:
+			case 1:
+				body = commits[0].Body
+			default:
+				var sb strings.Builder
+				for _, commit := range commits {
+					fmt.Fprintf(&sb, "- %s\n", commit.Subject)
+				}
+				body = sb.String()
+			}
+		}
+	}
+
+	return title, body, nil
+}
+
+// baseRemoteRef returns the remote-tracking ref of the base branch, fetching it if needed.
+// It returns the empty string if no remote points to the base repository.
+func baseRemoteRef(ctx context.Context, remotes []*git.Remote, baseOwner, baseRepo, base string) string {
+	for _, remote := range remotes {
+		if !strings.EqualFold(remote.Owner, baseOwner) || !strings.EqualFold(remote.Repo, baseRepo) {
+			continue
+		}
+		ref := "refs/remotes/" + remote.Name + "/" + base
+		if !git.HasRef(ctx, ref) {
+			if err := git.Fetch(ctx, remote.Name, base); err != nil {
+				return ""
+			}
+		}
+		return ref
+	}
+	return ""
+}
+
+// findPullRequestTemplate returns the content of the pull request template in the local git repository.
+func findPullRequestTemplate(ctx context.Context) string {
+	top, err := git.TopLevel(ctx)
+	if err != nil {
+		return ""
+	}
+
+	for _, name := range pullRequestTemplates {
+		if data, err := os.ReadFile(filepath.Join(top, name)); err == nil {
+			return string(data)
+		}
+	}
+
+	// multiple templates directory, use the first one
+	matches, _ := filepath.Glob(filepath.Join(top, ".github", "PULL_REQUEST_TEMPLATE", "*.md"))
+	for _, match := range matches {
+		if data, err := os.ReadFile(match); err == nil {
+			return string(data)
+		}
+	}
+
+	return ""
+}
+
+// humanizeBranch converts the branch name to the title, such as "fix/foo-bar" to "fix/foo bar".
+func humanizeBranch(branch string) string {
+	return strings.NewReplacer("-", " ", "_", " ").Replace(branch)
+}
+
+// newReviewersRequest returns the ReviewersRequest from the users and teams (org/team) names.
+func newReviewersRequest(reviewers []string) github.ReviewersRequest {
+	var req github.ReviewersRequest
+	for _, reviewer := range reviewers {
+		if i := strings.IndexByte(reviewer, '/'); i >= 0 {
+			req.TeamReviewers = append(req.TeamReviewers, reviewer[i+1:])
+			continue
+		}
+		req.Reviewers = append(req.Reviewers, reviewer)
+	}
+	return req
+}
@@ -0,0 +1,219 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/go-github/v38/github"
+	"github.com/spf13/cobra"
+	color "github.com/zchee/color/v2"
+)
+
+// diffStatWidth is the maximum width of the +/- graph of the diff stat.
+const diffStatWidth = 50
+
+type pullRequestDiffCmd struct {
+	ioStreams *IOStreams
+
+	nameOnly bool
+	stat     bool
+	color    string
+}
+
+func init() {
+	prCmd.AddCommand(newCmdPullRequestDiff())
+}
+
+func newCmdPullRequestDiff() *cobra.Command {
+	c := &pullRequestDiffCmd{
+		ioStreams: defaultIOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "diff <owner/repo> <number>",
+		Short: "Prints the unified diff of the pull request",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkArgs(cmd, args, 2, exactArgs, "<owner/repo> <number>"); err != nil {
+				return err
+			}
+			owner, repo, number, err := parseRepositoryNumber(args)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			return c.runDiff(ctx, owner, repo, number)
+		},
+		ValidArgsFunction: completeRepositoryPullRequest,
+	}
+
+	f := cmd.Flags()
+	f.BoolVar(&c.nameOnly, "name-only", false, "print only the names of the changed files")
+	f.BoolVar(&c.stat, "stat", false, "print the diffstat of the changed files")
+	f.StringVar(&c.color, "color", "auto", "colorize the diff. [auto, always, never]")
+
+	return cmd
+}
+
+func (c *pullRequestDiffCmd) runDiff(ctx context.Context, owner, repo string, number int) error {
+	if c.nameOnly && c.stat {
+		return errors.New("--name-only and --stat flags are mutually exclusive")
+	}
+	var colored bool
+	switch c.color {
+	case "auto":
+		colored = c.ioStreams.ColorEnabled()
+	case "always":
+		colored = true
+	case "never":
+	default:
+		return fmt.Errorf("invalid --color flag value %q: must be one of auto, always, never", c.color)
+	}
+
+	client := newClient(ctx)
+
+	diff, _, err := client.PullRequests.GetRaw(ctx, owner, repo, number, github.RawOptions{Type: github.Diff})
+	if err != nil {
+		return fmt.Errorf("could not get %s/%s#%d diff: %w", owner, repo, number, IsRateLimitError(err))
+	}
+
+	switch {
+	case c.nameOnly:
+		var sb strings.Builder
+		for _, stat := range parseDiffStats(diff) {
+			sb.WriteString(stat.name + "\n")
+		}
+		_, err = io.WriteString(c.ioStreams.Out, sb.String())
+	case c.stat:
+		err = writeDiffStat(c.ioStreams.Out, parseDiffStats(diff), colored)
+	default:
+		err = writeDiff(c.ioStreams.Out, diff, colored)
+	}
+
+	return err
+}
+
+// diffStat represents the numbers of the changed lines of the file.
+type diffStat struct {
+	name      string
+	additions int
+	deletions int
+}
+
+// parseDiffStats returns the changed files and its added and deleted lines of the unified diff.
+func parseDiffStats(diff string) []diffStat {
+	var stats []diffStat
+	inHunk := false
+	for _, line := range strings.Split(diff, "\n") {
+		switch {
+		case strings.HasPrefix(line, "diff --git "):
+			// diff --git a/<old> b/<new>
+			name := line[len("diff --git "):]
+			if i := strings.LastIndex(name, " b/"); i >= 0 {
+				name = name[i+len(" b/"):]
+			}
+			stats = append(stats, diffStat{name: name})
+			inHunk = false
+		case len(stats) == 0:
+		case strings.HasPrefix(line, "@@"):
+			inHunk = true
+		case !inHunk:
+		case strings.HasPrefix(line, "+"):
+			stats[len(stats)-1].additions++
+		case strings.HasPrefix(line, "-"):
+			stats[len(stats)-1].deletions++
+		}
+	}
+	return stats
+}
+
+// writeDiffStat writes stats in the git diff --stat format to w.
+func writeDiffStat(w io.Writer, stats []diffStat, colored bool) error {
+	green, red := color.New(color.FgGreen), color.New(color.FgRed)
+	if colored {
+		green.EnableColor()
+		red.EnableColor()
+	} else {
+		green.DisableColor()
+		red.DisableColor()
+	}
+
+	maxName, maxChanges := 0, 0
+	for _, stat := range stats {
+		if len(stat.name) > maxName {
+			maxName = len(stat.name)
+		}
+		if n := stat.additions + stat.deletions; n > maxChanges {
+			maxChanges = n
+		}
+	}
+	changesWidth := len(fmt.Sprint(maxChanges))
+
+	var sb strings.Builder
+	additions, deletions := 0, 0
+	for _, stat := range stats {
+		additions += stat.additions
+		deletions += stat.deletions
+
+		plus, minus := stat.additions, stat.deletions
+		if maxChanges > diffStatWidth {
+			plus = plus * diffStatWidth / maxChanges
+			minus = minus * diffStatWidth / maxChanges
+		}
+		fmt.Fprintf(&sb, " %-*s | %*d %s%s\n", maxName, stat.name, changesWidth, stat.additions+stat.deletions,
+			green.Sprint(strings.Repeat("+", plus)), red.Sprint(strings.Repeat("-", minus)))
+	}
+	fmt.Fprintf(&sb, " %d files changed, %d insertions(+), %d deletions(-)\n", len(stats), additions, deletions)
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// writeDiff writes the unified diff to w, colorized like git diff if colored is true.
+func writeDiff(w io.Writer, diff string, colored bool) error {
+	if !colored {
+		_, err := io.WriteString(w, diff)
+		return err
+	}
+
+	header, hunk := color.New(color.Bold), color.New(color.FgCyan)
+	added, deleted := color.New(color.FgGreen), color.New(color.FgRed)
+	for _, c := range []*color.Color{header, hunk, added, deleted} {
+		c.EnableColor()
+	}
+
+	bw := bufio.NewWriter(w)
+	inHunk := false
+	for _, line := range strings.SplitAfter(diff, "\n") {
+		text := strings.TrimSuffix(line, "\n")
+		newline := line[len(text):]
+		switch {
+		case text == "":
+		case strings.HasPrefix(text, "diff --git "):
+			inHunk = false
+			text = header.Sprint(text)
+		case strings.HasPrefix(text, "@@"):
+			inHunk = true
+			text = hunk.Sprint(text)
+		case !inHunk:
+			text = header.Sprint(text)
+		case strings.HasPrefix(text, "+"):
+			text = added.Sprint(text)
+		case strings.HasPrefix(text, "-"):
+			text = deleted.Sprint(text)
+		}
+		bw.WriteString(text + newline)
+	}
+
+	return bw.Flush()
+}
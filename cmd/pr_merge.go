@@ -0,0 +1,224 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v38/github"
+	"github.com/spf13/cobra"
+
+	"github.com/zchee/ghctl/pkg/spin"
+)
+
+// mergeMethods is the valid list of the merge methods.
+var mergeMethods = []string{"merge", "squash", "rebase"}
+
+// enableAutoMergeMutation is the GraphQL mutation which enables the auto-merge of the pull request.
+const enableAutoMergeMutation = `mutation($id: ID!, $method: PullRequestMergeMethod!, $headline: String, $body: String) {
+  enablePullRequestAutoMerge(input: {pullRequestId: $id, mergeMethod: $method, commitHeadline: $headline, commitBody: $body}) {
+    clientMutationId
+  }
+}`
+
+type pullRequestMergeCmd struct {
+	ioStreams *IOStreams
+
+	method       string
+	title        string
+	body         string
+	deleteBranch bool
+	whenGreen    bool
+	auto         bool
+	interval     time.Duration
+	timeout      time.Duration
+}
+
+func init() {
+	prCmd.AddCommand(newCmdPullRequestMerge())
+}
+
+func newCmdPullRequestMerge() *cobra.Command {
+	c := &pullRequestMergeCmd{
+		ioStreams: defaultIOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "merge <owner/repo> <number>",
+		Short: "Merges the pull request",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkArgs(cmd, args, 2, exactArgs, "<owner/repo> <number>"); err != nil {
+				return err
+			}
+			owner, repo, number, err := parseRepositoryNumber(args)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			return c.runMerge(ctx, owner, repo, number)
+		},
+		Annotations:       noPagerAnnotation(),
+		ValidArgsFunction: completeRepositoryPullRequest,
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&c.method, "method", "merge", "merge method. ["+strings.Join(mergeMethods, ", ")+"]")
+	f.StringVarP(&c.title, "title", "t", "", "title of the merge commit (default: GitHub default title)")
+	f.StringVarP(&c.body, "body", "b", "", "message of the merge commit (default: GitHub default message)")
+	f.BoolVarP(&c.deleteBranch, "delete-branch", "d", false, "delete the head branch after merge")
+	f.BoolVar(&c.whenGreen, "when-green", false, "wait until the all commit statuses and check runs pass before merge. fails if no checks are reported in 5m after the head commit")
+	f.BoolVar(&c.auto, "auto", false, "enable the GitHub auto-merge instead of merging immediately")
+	f.DurationVar(&c.interval, "interval", 30*time.Second, "polling interval of the checks with --when-green")
+	f.DurationVar(&c.timeout, "timeout", time.Hour, "maximum time to wait for the checks with --when-green. zero means no limit")
+
+	return cmd
+}
+
+func (c *pullRequestMergeCmd) runMerge(ctx context.Context, owner, repo string, number int) error {
+	if !matchSlice(c.method, mergeMethods) {
+		return fmt.Errorf("invalid --method flag value %q: must be one of %s", c.method, strings.Join(mergeMethods, ", "))
+	}
+	if c.auto && c.whenGreen {
+		return errors.New("--auto and --when-green flags are mutually exclusive")
+	}
+	if c.auto && c.deleteBranch {
+		return errors.New("--delete-branch flag is not supported with --auto. enable the automatically delete head branches setting of the repository instead")
+	}
+
+	client := newClient(ctx)
+
+	pr, _, err := client.PullRequests.Get(ctx, owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("could not get %s/%s#%d pull request: %w", owner, repo, number, IsRateLimitError(err))
+	}
+	switch {
+	case pr.GetMerged():
+		return fmt.Errorf("%s/%s#%d is already merged", owner, repo, number)
+	case pr.GetState() == "closed":
+		return fmt.Errorf("%s/%s#%d is closed", owner, repo, number)
+	}
+
+	if c.auto {
+		if err := c.enableAutoMerge(ctx, client, pr); err != nil {
+			return fmt.Errorf("could not enable auto-merge of %s/%s#%d: %w", owner, repo, number, err)
+		}
+		fmt.Fprintf(c.ioStreams.ErrOut, "enabled auto-merge of %s\n", pr.GetHTMLURL())
+		return nil
+	}
+
+	sha := pr.GetHead().GetSHA()
+	if c.whenGreen {
+		if err := c.waitChecks(ctx, client, owner, repo, sha); err != nil {
+			return err
+		}
+	}
+
+	result, _, err := client.PullRequests.Merge(ctx, owner, repo, number, c.body, &github.PullRequestOptions{
+		CommitTitle: c.title,
+		MergeMethod: c.method,
+		// fail if the head is pushed after the checks
+		SHA: sha,
+	})
+	if err != nil {
+		return fmt.Errorf("could not merge %s/%s#%d: %w", owner, repo, number, IsRateLimitError(err))
+	}
+	if !result.GetMerged() {
+		return fmt.Errorf("could not merge %s/%s#%d: %s", owner, repo, number, result.GetMessage())
+	}
+	fmt.Fprintf(c.ioStreams.ErrOut, "merged %s\n", pr.GetHTMLURL())
+
+	if c.deleteBranch {
+		head := pr.GetHead()
+		if head.GetRepo() == nil {
+			return fmt.Errorf("could not delete the head branch of %s/%s#%d: the head repository was deleted", owner, repo, number)
+		}
+		headOwner, headRepo := head.GetRepo().GetOwner().GetLogin(), head.GetRepo().GetName()
+		if _, err := client.Git.DeleteRef(ctx, headOwner, headRepo, "heads/"+head.GetRef()); err != nil {
+			return fmt.Errorf("could not delete %s/%s:%s branch: %w", headOwner, headRepo, head.GetRef(), IsRateLimitError(err))
+		}
+		fmt.Fprintf(c.ioStreams.ErrOut, "deleted %s/%s:%s branch\n", headOwner, headRepo, head.GetRef())
+	}
+
+	return nil
+}
+
+// waitChecks polls the commit statuses and check runs of sha until the all checks pass.
+// It returns the error with the failed check names if any check fails, or if no checks are reported within noChecksGrace.
+func (c *pullRequestMergeCmd) waitChecks(ctx context.Context, client *github.Client, owner, repo, sha string) error {
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	committed, err := headCommitTime(ctx, client, owner, repo, sha)
+	if err != nil {
+		return err
+	}
+
+	progress := spin.NewProgress(c.ioStreams.ErrOut)
+	task := progress.AddTask("waiting for checks", 0)
+	progress.Start()
+	defer progress.Stop()
+
+	for {
+		checks, err := listChecks(ctx, client, owner, repo, sha)
+		if err != nil {
+			return err
+		}
+		if len(checks) == 0 && time.Since(committed) >= noChecksGrace {
+			return fmt.Errorf("no checks reported for %s/%s@%.7s in %s after the commit", owner, repo, sha, noChecksGrace)
+		}
+
+		done := 0
+		for _, check := range checks {
+			if check.State != checkPending {
+				done++
+			}
+		}
+		task.SetTotal(len(checks))
+		task.Add(done - task.Completed())
+
+		state, failed := summarizeChecks(checks)
+		switch state {
+		case checkFailure:
+			return fmt.Errorf("checks of %s/%s@%.7s failed: %s", owner, repo, sha, strings.Join(failed, ", "))
+		case checkSuccess:
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("timed out waiting for the checks of %s/%s@%.7s", owner, repo, sha)
+			}
+			return ctx.Err()
+		case <-time.After(c.interval):
+		}
+	}
+}
+
+// enableAutoMerge enables the auto-merge of pr via the GraphQL API, which the REST API does not provide.
+func (c *pullRequestMergeCmd) enableAutoMerge(ctx context.Context, client *github.Client, pr *github.PullRequest) error {
+	variables := map[string]interface{}{
+		"id":     pr.GetNodeID(),
+		"method": strings.ToUpper(c.method),
+	}
+	if c.title != "" {
+		variables["headline"] = c.title
+	}
+	if c.body != "" {
+		variables["body"] = c.body
+	}
+
+	return doGraphQL(ctx, client, enableAutoMergeMutation, variables, nil)
+}
@@ -0,0 +1,216 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v38/github"
+	"github.com/spf13/cobra"
+)
+
+type pullRequestReviewCmd struct {
+	ioStreams *IOStreams
+
+	approve        bool
+	requestChanges bool
+	comment        bool
+	body           string
+	bodyFile       string
+	editor         bool
+	commentsFile   string
+}
+
+func init() {
+	prCmd.AddCommand(newCmdPullRequestReview())
+}
+
+func newCmdPullRequestReview() *cobra.Command {
+	c := &pullRequestReviewCmd{
+		ioStreams: defaultIOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "review <owner/repo> <number>",
+		Short: "Submits the review of the pull request",
+		Long: `Submits the review of the pull request.
+
+The line comments file has the one comment per line in the path:line:message format.
+The line can be the range such as path:10-12:message. Empty lines and lines starting with # are ignored.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkArgs(cmd, args, 2, exactArgs, "<owner/repo> <number>"); err != nil {
+				return err
+			}
+			owner, repo, number, err := parseRepositoryNumber(args)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			return c.runReview(ctx, owner, repo, number)
+		},
+		Annotations:       noPagerAnnotation(),
+		ValidArgsFunction: completeRepositoryPullRequest,
+	}
+
+	f := cmd.Flags()
+	f.BoolVarP(&c.approve, "approve", "a", false, "approve the pull request")
+	f.BoolVarP(&c.requestChanges, "request-changes", "r", false, "request changes on the pull request")
+	f.BoolVarP(&c.comment, "comment", "c", false, "comment on the pull request without approval")
+	f.StringVarP(&c.body, "body", "b", "", "body of the review")
+	f.StringVarP(&c.bodyFile, "body-file", "F", "", "read the body of the review from the file. \"-\" reads from stdin")
+	f.BoolVarP(&c.editor, "editor", "e", false, "write the body of the review in the editor")
+	f.StringVarP(&c.commentsFile, "line-comments", "l", "", "read the line comments from the file of path:line:message entries. \"-\" reads from stdin")
+
+	return cmd
+}
+
+// event returns the review event of the flags.
+func (c *pullRequestReviewCmd) event() (string, error) {
+	var events []string
+	if c.approve {
+		events = append(events, "APPROVE")
+	}
+	if c.requestChanges {
+		events = append(events, "REQUEST_CHANGES")
+	}
+	if c.comment {
+		events = append(events, "COMMENT")
+	}
+	if len(events) != 1 {
+		return "", errors.New("specify exactly one of --approve, --request-changes or --comment flags")
+	}
+	return events[0], nil
+}
+
+func (c *pullRequestReviewCmd) runReview(ctx context.Context, owner, repo string, number int) error {
+	event, err := c.event()
+	if err != nil {
+		return err
+	}
+
+	if c.bodyFile == "-" && c.commentsFile == "-" {
+		return errors.New("--body-file and --line-comments flags can not both read from stdin")
+	}
+
+	var comments []*github.DraftReviewComment
+	if c.commentsFile != "" {
+		if comments, err = c.readLineComments(); err != nil {
+			return err
+		}
+	}
+
+	body, ok, err := bodyFromFlags(ctx, c.ioStreams.In, c.body, c.bodyFile, c.editor, "")
+	if !ok && err == nil && event != "APPROVE" && len(comments) == 0 && canPrompt(c.ioStreams) {
+		body, err = editBody(ctx, "")
+	}
+	if errors.Is(err, errEditAborted) {
+		return fmt.Errorf("review is not submitted: %w", err)
+	}
+	if err != nil {
+		return err
+	}
+	if event != "APPROVE" && strings.TrimSpace(body) == "" && len(comments) == 0 {
+		return fmt.Errorf("body of the review is required for %s", strings.ToLower(strings.ReplaceAll(event, "_", " ")))
+	}
+
+	client := newClient(ctx)
+
+	review := &github.PullRequestReviewRequest{
+		Event:    github.String(event),
+		Comments: comments,
+	}
+	if body != "" {
+		review.Body = github.String(body)
+	}
+	result, _, err := client.PullRequests.CreateReview(ctx, owner, repo, number, review)
+	if err != nil {
+		return fmt.Errorf("could not create the review of %s/%s#%d: %w", owner, repo, number, IsRateLimitError(err))
+	}
+
+	fmt.Fprintln(c.ioStreams.Out, result.GetHTMLURL())
+
+	return nil
+}
+
+// readLineComments reads the line comments from the --line-comments file.
+func (c *pullRequestReviewCmd) readLineComments() ([]*github.DraftReviewComment, error) {
+	var r io.Reader = c.ioStreams.In
+	if c.commentsFile != "-" {
+		f, err := os.Open(c.commentsFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not open line comments file: %w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	comments, err := parseLineComments(r)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %s: %w", c.commentsFile, err)
+	}
+	return comments, nil
+}
+
+// parseLineComments parses the line comments of the path:line:message or path:start-end:message format from r.
+// The message can have the "\n" escape sequence as the newline.
+func parseLineComments(r io.Reader) ([]*github.DraftReviewComment, error) {
+	var comments []*github.DraftReviewComment
+
+	sc := bufio.NewScanner(r)
+	lineno := 0
+	for sc.Scan() {
+		lineno++
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		ss := strings.SplitN(line, ":", 3)
+		if len(ss) != 3 || ss[0] == "" || strings.TrimSpace(ss[2]) == "" {
+			return nil, fmt.Errorf("line %d: must be path:line:message", lineno)
+		}
+		path, lines, message := ss[0], ss[1], strings.TrimSpace(ss[2])
+
+		comment := &github.DraftReviewComment{
+			Path: github.String(path),
+			Body: github.String(strings.ReplaceAll(message, `\n`, "\n")),
+			Side: github.String("RIGHT"),
+		}
+		start, end := lines, lines
+		if i := strings.IndexByte(lines, '-'); i >= 0 {
+			start, end = lines[:i], lines[i+1:]
+		}
+		startLine, err := strconv.Atoi(start)
+		if err != nil || startLine <= 0 {
+			return nil, fmt.Errorf("line %d: invalid line number %q", lineno, lines)
+		}
+		endLine, err := strconv.Atoi(end)
+		if err != nil || endLine < startLine {
+			return nil, fmt.Errorf("line %d: invalid line number %q", lineno, lines)
+		}
+		comment.Line = github.Int(endLine)
+		if startLine != endLine {
+			comment.StartLine = github.Int(startLine)
+			comment.StartSide = github.String("RIGHT")
+		}
+
+		comments = append(comments, comment)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
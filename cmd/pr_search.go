@@ -0,0 +1,246 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v38/github"
+
+	"github.com/zchee/ghctl/pkg/ignore"
+)
+
+// searchMaxResults is the maximum number of results the GitHub search API provides for each query.
+const searchMaxResults = 1000
+
+// githubEpoch is the lower bound of the date range used when splitting the search query.
+var githubEpoch = time.Date(2008, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// searchDateLayout is the layout of the date and time in the search qualifiers.
+const searchDateLayout = "2006-01-02T15:04:05-07:00"
+
+// pullRequestSearch represents the search qualifiers of the pull requests.
+type pullRequestSearch struct {
+	author          string
+	reviewedBy      string
+	reviewRequested string
+	assignee        string
+	involves        string
+	mentions        string
+	orgs            []string
+	repos           []string
+
+	// dateField is the date field which since and until filters, one of created, updated or merged.
+	dateField string
+	since     time.Time
+	until     time.Time
+
+	labels        []string
+	excludeLabels []string
+	base          string
+	draft         *bool
+
+	sort  string
+	order string
+
+	// ignore is the rules of the owner and owner/repo names to exclude from the result.
+	ignore *ignore.Rules
+}
+
+// hasInvolvement reports whether q has any of involvement qualifiers other than the author.
+func (q *pullRequestSearch) hasInvolvement() bool {
+	return q.reviewedBy != "" || q.reviewRequested != "" || q.assignee != "" || q.involves != "" || q.mentions != ""
+}
+
+// searchInterval is the interval of the paced search API requests, which is limited to 30 requests per minute.
+const searchInterval = 2 * time.Second
+
+// searchPacer paces the sequential search API requests by searchInterval.
+type searchPacer struct {
+	last time.Time
+}
+
+// wait waits until the next search request can be sent, or ctx is done.
+func (p *searchPacer) wait(ctx context.Context) error {
+	if d := searchInterval - time.Since(p.last); !p.last.IsZero() && d > 0 {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	p.last = time.Now()
+	return nil
+}
+
+// dateFields is the valid list of pullRequestSearch.dateField.
+var dateFields = []string{"created", "updated", "merged"}
+
+// parseSearchDate parses s as the date (2006-01-02) or RFC3339 time.
+// If s is date only and endOfDay is true, returns the last second of the day.
+func parseSearchDate(s string, endOfDay bool) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation("2006-01-02", s, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q: must be YYYY-MM-DD or RFC3339", s)
+	}
+	if endOfDay {
+		t = t.Add(24*time.Hour - time.Second)
+	}
+	return t, nil
+}
+
+// quoteQualifier quotes the qualifier value if it contains any space.
+func quoteQualifier(s string) string {
+	if strings.ContainsAny(s, " \t") {
+		return `"` + s + `"`
+	}
+	return s
+}
+
+// query returns the search query of q for state in the date range of since and until.
+// The zero since or until means the unbounded range.
+func (q *pullRequestSearch) query(state pullRequestState, since, until time.Time) string {
+	qs := []string{"type:pr"}
+	if q.author != "" {
+		qs = append(qs, "author:"+q.author)
+	}
+	if q.reviewedBy != "" {
+		qs = append(qs, "reviewed-by:"+q.reviewedBy)
+	}
+	if q.reviewRequested != "" {
+		// the team review request has the different qualifier from the user
+		if strings.Contains(q.reviewRequested, "/") {
+			qs = append(qs, "team-review-requested:"+q.reviewRequested)
+		} else {
+			qs = append(qs, "review-requested:"+q.reviewRequested)
+		}
+	}
+	if q.assignee != "" {
+		qs = append(qs, "assignee:"+q.assignee)
+	}
+	if q.involves != "" {
+		qs = append(qs, "involves:"+q.involves)
+	}
+	if q.mentions != "" {
+		qs = append(qs, "mentions:"+q.mentions)
+	}
+	for _, org := range q.orgs {
+		qs = append(qs, "org:"+org)
+	}
+	if qualifier := state.qualifier(); qualifier != "" {
+		qs = append(qs, qualifier)
+	}
+	for _, repo := range q.repos {
+		if strings.Contains(repo, "/") {
+			qs = append(qs, "repo:"+repo)
+		} else {
+			qs = append(qs, "user:"+repo)
+		}
+	}
+
+	field := q.dateField
+	if field == "" {
+		field = "created"
+	}
+	switch {
+	case !since.IsZero() && !until.IsZero():
+		qs = append(qs, fmt.Sprintf("%s:%s..%s", field, since.UTC().Format(searchDateLayout), until.UTC().Format(searchDateLayout)))
+	case !since.IsZero():
+		qs = append(qs, fmt.Sprintf("%s:>=%s", field, since.UTC().Format(searchDateLayout)))
+	case !until.IsZero():
+		qs = append(qs, fmt.Sprintf("%s:<=%s", field, until.UTC().Format(searchDateLayout)))
+	}
+
+	for _, label := range q.labels {
+		qs = append(qs, "label:"+quoteQualifier(label))
+	}
+	for _, label := range q.excludeLabels {
+		qs = append(qs, "-label:"+quoteQualifier(label))
+	}
+	if q.base != "" {
+		qs = append(qs, "base:"+q.base)
+	}
+	if q.draft != nil {
+		qs = append(qs, fmt.Sprintf("draft:%t", *q.draft))
+	}
+
+	return strings.Join(qs, " ")
+}
+
+// searchPullRequests searches the pull requests of state which match q.
+//
+// The GitHub search API only provides up to searchMaxResults results for each query,
+// so the date range is split in half recursively until each query fits into the limit.
+func searchPullRequests(ctx context.Context, client *github.Client, q *pullRequestSearch, state pullRequestState) ([]pullRequestListItem, error) {
+	return searchPullRequestsRange(ctx, client, q, state, q.since, q.until)
+}
+
+func searchPullRequestsRange(ctx context.Context, client *github.Client, q *pullRequestSearch, state pullRequestState, since, until time.Time) ([]pullRequestListItem, error) {
+	query := q.query(state, since, until)
+	opts := &github.SearchOptions{
+		Sort:  q.sort,
+		Order: q.order,
+		ListOptions: github.ListOptions{
+			Page:    1,
+			PerPage: 100,
+		},
+	}
+
+	var items []pullRequestListItem
+	for {
+		result, resp, err := client.Search.Issues(ctx, query, opts)
+		if err != nil {
+			return nil, fmt.Errorf("could not get search pull request result: %w", IsRateLimitError(err))
+		}
+
+		if opts.Page == 1 && result.GetTotal() > searchMaxResults {
+			lower, upper := since, until
+			if lower.IsZero() {
+				lower = githubEpoch
+			}
+			if upper.IsZero() {
+				upper = time.Now()
+			}
+			if upper.Sub(lower) > time.Second {
+				mid := lower.Add(upper.Sub(lower) / 2).Truncate(time.Second)
+				first, err := searchPullRequestsRange(ctx, client, q, state, lower, mid)
+				if err != nil {
+					return nil, err
+				}
+				second, err := searchPullRequestsRange(ctx, client, q, state, mid.Add(time.Second), upper)
+				if err != nil {
+					return nil, err
+				}
+				return append(first, second...), nil
+			}
+		}
+
+		for _, issue := range result.Issues {
+			owner, repo := getRepoOwnerAndName(issue.GetURL())
+			if q.ignore.Match(owner, repo) {
+				continue
+			}
+			items = append(items, newPullRequestListItem(issue, state))
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return items, nil
+}
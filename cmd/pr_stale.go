@@ -0,0 +1,436 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+	"time"
+
+	"github.com/google/go-github/v38/github"
+	"github.com/spf13/cobra"
+
+	"github.com/zchee/ghctl/pkg/config"
+	"github.com/zchee/ghctl/pkg/spin"
+)
+
+type pullRequestStaleCmd struct {
+	ioStreams *IOStreams
+
+	days       int
+	closeDays  int
+	comment    bool
+	addLabel   bool
+	close      bool
+	apply      bool
+	configFile string
+}
+
+func init() {
+	prCmd.AddCommand(newCmdPullRequestStale())
+}
+
+func newCmdPullRequestStale() *cobra.Command {
+	c := &pullRequestStaleCmd{
+		ioStreams: defaultIOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "stale <owner/repo>",
+		Short: "Finds the open pull requests with no activity, and reminds, labels or closes them",
+		Long: `Finds the open pull requests with no activity for the days, and reminds, labels or closes them.
+
+It is the dry-run by default, which only lists the stale pull requests and the operations. Use --apply to perform them.
+The last activity is the latest commit, review or comment, except the comments of the bots and the reminder comments
+of this command. So the reminder comment and label do not postpone closing, and --close-days must be greater than --days.
+The defaults of the days, label and comment templates can be configured in the stale section of the config file.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkArgs(cmd, args, 1, exactArgs, "<owner/repo>"); err != nil {
+				return err
+			}
+			owner, repo, err := splitOwnerRepo(args[0])
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			return c.runStale(ctx, cmd, owner, repo)
+		},
+		Annotations:       noPagerAnnotation(),
+		ValidArgsFunction: completeRepositoryNames,
+	}
+
+	f := cmd.Flags()
+	f.IntVar(&c.days, "days", config.DefaultStale.Days, "number of inactive days which the pull request is stale")
+	f.IntVar(&c.closeDays, "close-days", 0, "number of inactive days which the stale pull request is closed with --close")
+	f.BoolVar(&c.comment, "comment", false, "post the reminder comment on the stale pull requests")
+	f.BoolVar(&c.addLabel, "add-label", false, "add the stale label to the stale pull requests")
+	f.BoolVar(&c.close, "close", false, "close the pull requests inactive for --close-days")
+	f.BoolVar(&c.apply, "apply", false, "perform the operations. the default is the dry-run")
+	f.StringVar(&c.configFile, "config", "", "path of the config file which has the stale section (default: config.json in the config directory)")
+
+	return cmd
+}
+
+// staleAction represents the operation on the stale pull request.
+type staleAction int
+
+const (
+	staleRemind staleAction = iota
+	staleClose
+)
+
+// stalePullRequest represents the stale pull request and the operation on it.
+type stalePullRequest struct {
+	pr           *github.PullRequest
+	lastActivity time.Time
+	inactive     time.Duration
+	action       staleAction
+}
+
+// staleCommentMarker is appended to the comments of the pr stale command, to not count them as the activity.
+const staleCommentMarker = "<!-- ghctl:stale -->"
+
+// staleTemplateData is the data of the stale comment templates.
+type staleTemplateData struct {
+	Author    string
+	Number    int
+	Title     string
+	URL       string
+	Days      int
+	CloseDays int
+}
+
+func (c *pullRequestStaleCmd) runStale(ctx context.Context, cmd *cobra.Command, owner, repo string) error {
+	cfg, err := config.Load(c.configFile)
+	if err != nil {
+		return err
+	}
+	staleCfg := mergeStaleConfig(cfg.Stale)
+	if !cmd.Flags().Changed("days") {
+		c.days = staleCfg.Days
+	}
+	if !cmd.Flags().Changed("close-days") {
+		c.closeDays = staleCfg.CloseDays
+	}
+	if err := c.validateDays(); err != nil {
+		return err
+	}
+
+	commentTmpl, err := template.New("comment").Parse(staleCfg.Comment)
+	if err != nil {
+		return fmt.Errorf("could not parse stale comment template: %w", err)
+	}
+	closeTmpl, err := template.New("close_comment").Parse(staleCfg.CloseComment)
+	if err != nil {
+		return fmt.Errorf("could not parse stale close comment template: %w", err)
+	}
+
+	client := newClient(ctx)
+
+	progress := spin.NewProgress(c.ioStreams.ErrOut)
+	progress.AddTask("fetching pull requests", 0)
+	progress.Start()
+	var activities map[int]time.Time
+	prs, err := listPullRequests(ctx, client, owner, repo, &github.PullRequestListOptions{
+		State:       "open",
+		Sort:        "updated",
+		Direction:   "asc",
+		ListOptions: github.ListOptions{PerPage: 100},
+	})
+	if err == nil {
+		activities, err = pullRequestActivities(ctx, client, owner, repo)
+	}
+	progress.Stop()
+	if err != nil {
+		return err
+	}
+
+	stales := c.findStale(prs, activities, time.Now())
+	if len(stales) == 0 {
+		fmt.Fprintf(c.ioStreams.ErrOut, "no pull requests inactive for %d days\n", c.days)
+		return nil
+	}
+	if err := c.writeStale(c.ioStreams.Out, stales, staleCfg.Label); err != nil {
+		return err
+	}
+
+	if !c.apply {
+		fmt.Fprintln(c.ioStreams.ErrOut, "\ndry-run: use --apply to perform the operations")
+		return nil
+	}
+
+	// the write requests are not concurrent, since the concurrent content creation hits the secondary rate limit
+	var pacer writePacer
+	failed := 0
+	for _, stale := range stales {
+		tmpl := commentTmpl
+		if stale.action == staleClose {
+			tmpl = closeTmpl
+		}
+		if err := c.applyStale(ctx, client, &pacer, owner, repo, stale, tmpl, staleCfg.Label); err != nil {
+			failed++
+			fmt.Fprintf(c.ioStreams.Out, "X %s/%s#%d: %v\n", owner, repo, stale.pr.GetNumber(), err)
+			continue
+		}
+		fmt.Fprintf(c.ioStreams.Out, "✓ %s/%s#%d\n", owner, repo, stale.pr.GetNumber())
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d pull requests failed", failed, len(stales))
+	}
+	return nil
+}
+
+// validateDays validates the --days and --close-days flags.
+func (c *pullRequestStaleCmd) validateDays() error {
+	if c.days <= 0 {
+		return errors.New("--days flag must be positive")
+	}
+	if c.close && c.closeDays <= 0 {
+		return errors.New("--close flag requires the positive --close-days flag or close_days config")
+	}
+	if c.close && c.closeDays <= c.days {
+		return fmt.Errorf("--close-days (%d) must be greater than --days (%d), to remind before closing", c.closeDays, c.days)
+	}
+	return nil
+}
+
+// mergeStaleConfig returns cfg which zero fields are filled by config.DefaultStale.
+func mergeStaleConfig(cfg config.Stale) config.Stale {
+	if cfg.Days == 0 {
+		cfg.Days = config.DefaultStale.Days
+	}
+	if cfg.Label == "" {
+		cfg.Label = config.DefaultStale.Label
+	}
+	if cfg.Comment == "" {
+		cfg.Comment = config.DefaultStale.Comment
+	}
+	if cfg.CloseComment == "" {
+		cfg.CloseComment = config.DefaultStale.CloseComment
+	}
+	return cfg
+}
+
+// staleActivityQuery is the GraphQL query of the activities of the open pull requests.
+const staleActivityQuery = `query($owner: String!, $repo: String!, $after: String) {
+  repository(owner: $owner, name: $repo) {
+    pullRequests(states: OPEN, first: 50, after: $after) {
+      nodes {
+        number
+        createdAt
+        commits(last: 1) { nodes { commit { committedDate } } }
+        reviews(last: 1) { nodes { submittedAt } }
+        comments(last: 20) { nodes { createdAt body author { __typename } } }
+      }
+      pageInfo { hasNextPage endCursor }
+    }
+  }
+}`
+
+// pullRequestActivities returns the last activity of the open pull requests of owner/repo keyed by the number.
+// The activity is the creation, the latest commit, review or comment, except the bot comments and staleCommentMarker comments.
+func pullRequestActivities(ctx context.Context, client *github.Client, owner, repo string) (map[int]time.Time, error) {
+	var data struct {
+		Repository struct {
+			PullRequests struct {
+				Nodes []struct {
+					Number    int       `json:"number"`
+					CreatedAt time.Time `json:"createdAt"`
+					Commits   struct {
+						Nodes []struct {
+							Commit struct {
+								CommittedDate time.Time `json:"committedDate"`
+							} `json:"commit"`
+						} `json:"nodes"`
+					} `json:"commits"`
+					Reviews struct {
+						Nodes []struct {
+							SubmittedAt time.Time `json:"submittedAt"`
+						} `json:"nodes"`
+					} `json:"reviews"`
+					Comments struct {
+						Nodes []struct {
+							CreatedAt time.Time `json:"createdAt"`
+							Body      string    `json:"body"`
+							Author    struct {
+								Typename string `json:"__typename"`
+							} `json:"author"`
+						} `json:"nodes"`
+					} `json:"comments"`
+				} `json:"nodes"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"pullRequests"`
+		} `json:"repository"`
+	}
+
+	activities := make(map[int]time.Time)
+	variables := map[string]interface{}{
+		"owner": owner,
+		"repo":  repo,
+	}
+	for {
+		if err := doGraphQL(ctx, client, staleActivityQuery, variables, &data); err != nil {
+			return nil, fmt.Errorf("could not get activities of %s/%s pull requests: %w", owner, repo, err)
+		}
+		for _, pr := range data.Repository.PullRequests.Nodes {
+			last := pr.CreatedAt
+			for _, commit := range pr.Commits.Nodes {
+				last = latestTime(last, commit.Commit.CommittedDate)
+			}
+			for _, review := range pr.Reviews.Nodes {
+				last = latestTime(last, review.SubmittedAt)
+			}
+			for _, comment := range pr.Comments.Nodes {
+				if comment.Author.Typename == "Bot" || strings.Contains(comment.Body, staleCommentMarker) {
+					continue
+				}
+				last = latestTime(last, comment.CreatedAt)
+			}
+			activities[pr.Number] = last
+		}
+		if !data.Repository.PullRequests.PageInfo.HasNextPage {
+			break
+		}
+		variables["after"] = data.Repository.PullRequests.PageInfo.EndCursor
+	}
+
+	return activities, nil
+}
+
+// latestTime returns the later time of a and b.
+func latestTime(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
+
+// findStale returns the pull requests of prs inactive for c.days at now, oldest activity first.
+// The last activity of the pull request is looked up from activities, or the update time if it is not found.
+func (c *pullRequestStaleCmd) findStale(prs []*github.PullRequest, activities map[int]time.Time, now time.Time) []stalePullRequest {
+	staleAge := time.Duration(c.days) * 24 * time.Hour
+	closeAge := time.Duration(c.closeDays) * 24 * time.Hour
+
+	var stales []stalePullRequest
+	for _, pr := range prs {
+		last, ok := activities[pr.GetNumber()]
+		if !ok {
+			last = pr.GetUpdatedAt()
+		}
+		inactive := now.Sub(last)
+		if inactive < staleAge {
+			continue
+		}
+		action := staleRemind
+		if c.close && inactive >= closeAge {
+			action = staleClose
+		}
+		stales = append(stales, stalePullRequest{pr: pr, lastActivity: last, inactive: inactive, action: action})
+	}
+	sort.SliceStable(stales, func(i, j int) bool {
+		return stales[i].lastActivity.Before(stales[j].lastActivity)
+	})
+	return stales
+}
+
+// operations returns the human readable operations on stale.
+func (c *pullRequestStaleCmd) operations(stale stalePullRequest, label string) string {
+	var ops []string
+	if stale.action == staleClose {
+		if c.comment {
+			ops = append(ops, "comment")
+		}
+		ops = append(ops, "close")
+		return strings.Join(ops, ", ")
+	}
+	if c.comment {
+		ops = append(ops, "remind")
+	}
+	if c.addLabel && !hasAnyLabel(stale.pr.Labels, []string{label}) {
+		ops = append(ops, "label "+label)
+	}
+	if len(ops) == 0 {
+		return "-"
+	}
+	return strings.Join(ops, ", ")
+}
+
+func (c *pullRequestStaleCmd) writeStale(w io.Writer, stales []stalePullRequest, label string) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "NUMBER\tAUTHOR\tLAST ACTIVITY\tINACTIVE\tACTION\tTITLE")
+	for _, stale := range stales {
+		fmt.Fprintf(tw, "#%d\t%s\t%s\t%dd\t%s\t%s\n",
+			stale.pr.GetNumber(), stale.pr.GetUser().GetLogin(), stale.lastActivity.Format("2006-01-02"),
+			int(stale.inactive.Hours()/24), c.operations(stale, label), stale.pr.GetTitle())
+	}
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("could not flush tabwriter: %w", err)
+	}
+	return nil
+}
+
+// applyStale performs the operations on stale.
+func (c *pullRequestStaleCmd) applyStale(ctx context.Context, client *github.Client, pacer *writePacer, owner, repo string, stale stalePullRequest, tmpl *template.Template, label string) error {
+	number := stale.pr.GetNumber()
+
+	if c.comment {
+		closeDays := 0
+		if c.close {
+			closeDays = c.closeDays
+		}
+		var sb strings.Builder
+		if err := tmpl.Execute(&sb, staleTemplateData{
+			Author:    stale.pr.GetUser().GetLogin(),
+			Number:    number,
+			Title:     stale.pr.GetTitle(),
+			URL:       stale.pr.GetHTMLURL(),
+			Days:      int(stale.inactive.Hours() / 24),
+			CloseDays: closeDays,
+		}); err != nil {
+			return fmt.Errorf("could not execute comment template: %w", err)
+		}
+		sb.WriteString("\n\n" + staleCommentMarker)
+		if err := pacer.do(ctx, func() error {
+			_, _, err := client.Issues.CreateComment(ctx, owner, repo, number, &github.IssueComment{Body: github.String(sb.String())})
+			return err
+		}); err != nil {
+			return fmt.Errorf("could not comment: %w", IsRateLimitError(err))
+		}
+	}
+
+	if stale.action == staleClose {
+		if err := pacer.do(ctx, func() error {
+			_, _, err := client.PullRequests.Edit(ctx, owner, repo, number, &github.PullRequest{State: github.String("closed")})
+			return err
+		}); err != nil {
+			return fmt.Errorf("could not close: %w", IsRateLimitError(err))
+		}
+		return nil
+	}
+
+	if c.addLabel && !hasAnyLabel(stale.pr.Labels, []string{label}) {
+		if err := pacer.do(ctx, func() error {
+			_, _, err := client.Issues.AddLabelsToIssue(ctx, owner, repo, number, []string{label})
+			return err
+		}); err != nil {
+			return fmt.Errorf("could not add %s label: %w", label, IsRateLimitError(err))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v38/github"
+)
+
+func TestFindStale(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	daysAgo := func(days int) time.Time { return now.Add(-time.Duration(days) * 24 * time.Hour) }
+	timePtr := func(t time.Time) *time.Time { return &t }
+
+	// the reminder comment and label of the previous run updated the all pull requests yesterday
+	prs := []*github.PullRequest{
+		{Number: github.Int(1), UpdatedAt: timePtr(daysAgo(1))},
+		{Number: github.Int(2), UpdatedAt: timePtr(daysAgo(1))},
+		{Number: github.Int(3), UpdatedAt: timePtr(daysAgo(1))},
+		{Number: github.Int(4), UpdatedAt: timePtr(daysAgo(45))},
+	}
+	activities := map[int]time.Time{
+		1: daysAgo(10),
+		2: daysAgo(40),
+		3: daysAgo(70),
+	}
+
+	type want struct {
+		number int
+		action staleAction
+	}
+	tests := []struct {
+		name string
+		c    *pullRequestStaleCmd
+		want []want
+	}{
+		{
+			name: "remind",
+			c:    &pullRequestStaleCmd{days: 30},
+			want: []want{{3, staleRemind}, {4, staleRemind}, {2, staleRemind}},
+		},
+		{
+			name: "close after the reminder",
+			c:    &pullRequestStaleCmd{days: 30, closeDays: 60, close: true},
+			want: []want{{3, staleClose}, {4, staleRemind}, {2, staleRemind}},
+		},
+		{
+			name: "close-days without close",
+			c:    &pullRequestStaleCmd{days: 30, closeDays: 60},
+			want: []want{{3, staleRemind}, {4, staleRemind}, {2, staleRemind}},
+		},
+		{
+			name: "nothing stale",
+			c:    &pullRequestStaleCmd{days: 90},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stales := tt.c.findStale(prs, activities, now)
+			if len(stales) != len(tt.want) {
+				t.Fatalf("findStale returned %d pull requests, want %d", len(stales), len(tt.want))
+			}
+			for i, stale := range stales {
+				if got := (want{stale.pr.GetNumber(), stale.action}); got != tt.want[i] {
+					t.Errorf("stales[%d] = %+v, want %+v", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestValidateDays(t *testing.T) {
+	tests := []struct {
+		name    string
+		c       *pullRequestStaleCmd
+		wantErr bool
+	}{
+		{name: "days only", c: &pullRequestStaleCmd{days: 30}},
+		{name: "close", c: &pullRequestStaleCmd{days: 30, closeDays: 60, close: true}},
+		{name: "zero days", c: &pullRequestStaleCmd{}, wantErr: true},
+		{name: "close without close-days", c: &pullRequestStaleCmd{days: 30, close: true}, wantErr: true},
+		{name: "close-days less than days", c: &pullRequestStaleCmd{days: 30, closeDays: 3, close: true}, wantErr: true},
+		{name: "close-days equal to days", c: &pullRequestStaleCmd{days: 30, closeDays: 30, close: true}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.c.validateDays(); (err != nil) != tt.wantErr {
+				t.Errorf("validateDays() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,354 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/go-github/v38/github"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/zchee/ghctl/pkg/spin"
+)
+
+// outputChart is the ASCII bar chart output format of the pr stats command.
+const outputChart outputFormat = "chart"
+
+// statsChartWidth is the width of the longest bar of the chart.
+const statsChartWidth = 50
+
+type pullRequestStatsCmd struct {
+	ioStreams *IOStreams
+
+	output string
+}
+
+func init() {
+	prCmd.AddCommand(newCmdPullRequestStats())
+}
+
+func newCmdPullRequestStats() *cobra.Command {
+	c := &pullRequestStatsCmd{
+		ioStreams: defaultIOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "stats [owner|owner/repo]...",
+		Short: "Aggregates the contribution statistics of pull requests. takes the same flags as pr list",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			return c.runStats(ctx, cmd, args)
+		},
+		ValidArgsFunction: completeOwnersOrRepositoryNames,
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(&c.output, "output", "o", string(outputText), "output format. [text, json, chart]")
+	addPullRequestSearchFlags(f)
+
+	return cmd
+}
+
+// statsCount represents the count of the named group.
+type statsCount struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// pullRequestStats represents the aggregated statistics of pull requests.
+type pullRequestStats struct {
+	Total                    int          `json:"total"`
+	Merged                   int          `json:"merged"`
+	PerRepository            []statsCount `json:"per_repository"`
+	PerMonth                 []statsCount `json:"per_month"`
+	MedianTimeToMerge        string       `json:"median_time_to_merge"`
+	MedianTimeToMergeSeconds float64      `json:"median_time_to_merge_seconds"`
+	Additions                int          `json:"additions"`
+	Deletions                int          `json:"deletions"`
+	ReviewRounds             int          `json:"review_rounds"`
+	AverageReviewRounds      float64      `json:"average_review_rounds"`
+}
+
+// pullRequestDetail represents the pull request with the details which the search API does not provide.
+type pullRequestDetail struct {
+	pullRequestListItem
+
+	mergedAt     time.Time
+	additions    int
+	deletions    int
+	reviewRounds int
+}
+
+func (c *pullRequestStatsCmd) runStats(ctx context.Context, cmd *cobra.Command, args []string) error {
+	format, err := parseOutputFormat(c.output, outputText, outputJSON, outputChart)
+	if err != nil {
+		return err
+	}
+	state, err := parsePullRequestListState()
+	if err != nil {
+		return err
+	}
+	search, err := newPullRequestSearch(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	client := newClient(ctx)
+
+	progress := spin.NewProgress(c.ioStreams.ErrOut)
+	searchTask := progress.AddTask("searching pull requests", 0)
+	progress.Start()
+	items, err := listPullRequestItems(ctx, client, search, state)
+	if err != nil {
+		progress.Stop()
+		return err
+	}
+	searchTask.SetTotal(1)
+	searchTask.Increment()
+
+	details, err := getPullRequestDetails(ctx, client, items, progress)
+	progress.Stop()
+	if err != nil {
+		return err
+	}
+
+	stats := aggregatePullRequestStats(details, prDateField)
+
+	switch format {
+	case outputJSON:
+		return writeJSON(c.ioStreams.Out, stats)
+	case outputChart:
+		return writePullRequestStatsChart(c.ioStreams.Out, stats)
+	default:
+		return writePullRequestStatsTable(c.ioStreams.Out, stats)
+	}
+}
+
+// getPullRequestDetails fetches the details of items concurrently.
+func getPullRequestDetails(ctx context.Context, client *github.Client, items []pullRequestListItem, progress *spin.Progress) ([]pullRequestDetail, error) {
+	task := progress.AddTask("fetching pull request details", len(items))
+
+	details := make([]pullRequestDetail, len(items))
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := make(chan struct{}, 20) // for concurrency API access limit
+
+	for i, item := range items {
+		sem <- struct{}{}
+		i, item := i, item
+		eg.Go(func() error {
+			defer func() { <-sem }()
+
+			pr, resp, err := client.PullRequests.Get(ctx, item.Owner, item.Repo, item.Number)
+			if err != nil {
+				return fmt.Errorf("could not get %s/%s#%d pull request: %w", item.Owner, item.Repo, item.Number, IsRateLimitError(err))
+			}
+			progress.SetRateLimit(resp.Rate.Remaining, resp.Rate.Limit)
+
+			rounds, err := countReviewRounds(ctx, client, item.Owner, item.Repo, item.Number)
+			if err != nil {
+				return err
+			}
+
+			details[i] = pullRequestDetail{
+				pullRequestListItem: item,
+				mergedAt:            pr.GetMergedAt(),
+				additions:           pr.GetAdditions(),
+				deletions:           pr.GetDeletions(),
+				reviewRounds:        rounds,
+			}
+			task.Increment()
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return details, nil
+}
+
+// countReviewRounds returns the number of review rounds of the pull request.
+// The review round is counted as the distinct commits which submitted reviews target.
+func countReviewRounds(ctx context.Context, client *github.Client, owner, repo string, number int) (int, error) {
+	commits := make(map[string]bool)
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		reviews, resp, err := client.PullRequests.ListReviews(ctx, owner, repo, number, opts)
+		if err != nil {
+			return 0, fmt.Errorf("could not list %s/%s#%d reviews: %w", owner, repo, number, IsRateLimitError(err))
+		}
+		for _, review := range reviews {
+			if review.GetState() == "PENDING" {
+				continue
+			}
+			commits[review.GetCommitID()] = true
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return len(commits), nil
+}
+
+// aggregatePullRequestStats aggregates details. The per month counts are grouped by the dateField date.
+func aggregatePullRequestStats(details []pullRequestDetail, dateField string) *pullRequestStats {
+	stats := &pullRequestStats{
+		Total: len(details),
+	}
+
+	perRepo := make(map[string]int)
+	perMonth := make(map[string]int)
+	var mergeDurations []time.Duration
+	for _, d := range details {
+		perRepo[d.Owner+"/"+d.Repo]++
+
+		date := d.CreatedAt
+		switch dateField {
+		case "updated":
+			date = d.UpdatedAt
+		case "merged":
+			if !d.mergedAt.IsZero() {
+				date = d.mergedAt
+			}
+		}
+		perMonth[date.Format("2006-01")]++
+
+		if !d.mergedAt.IsZero() {
+			stats.Merged++
+			mergeDurations = append(mergeDurations, d.mergedAt.Sub(d.CreatedAt))
+		}
+		stats.Additions += d.additions
+		stats.Deletions += d.deletions
+		stats.ReviewRounds += d.reviewRounds
+	}
+
+	for name, count := range perRepo {
+		stats.PerRepository = append(stats.PerRepository, statsCount{Name: name, Count: count})
+	}
+	sort.Slice(stats.PerRepository, func(i, j int) bool {
+		if stats.PerRepository[i].Count != stats.PerRepository[j].Count {
+			return stats.PerRepository[i].Count > stats.PerRepository[j].Count
+		}
+		return stats.PerRepository[i].Name < stats.PerRepository[j].Name
+	})
+
+	for name, count := range perMonth {
+		stats.PerMonth = append(stats.PerMonth, statsCount{Name: name, Count: count})
+	}
+	sort.Slice(stats.PerMonth, func(i, j int) bool { return stats.PerMonth[i].Name < stats.PerMonth[j].Name })
+
+	if median := medianDuration(mergeDurations); median > 0 {
+		stats.MedianTimeToMerge = formatDuration(median)
+		stats.MedianTimeToMergeSeconds = median.Seconds()
+	}
+	if stats.Total > 0 {
+		stats.AverageReviewRounds = float64(stats.ReviewRounds) / float64(stats.Total)
+	}
+
+	return stats
+}
+
+// medianDuration returns the median of ds. It returns zero if ds is empty.
+func medianDuration(ds []time.Duration) time.Duration {
+	if len(ds) == 0 {
+		return 0
+	}
+	sorted := make([]time.Duration, len(ds))
+	copy(sorted, ds)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+// formatDuration formats d as the days and hours, such as 3d4h.
+func formatDuration(d time.Duration) string {
+	days := int(d / (24 * time.Hour))
+	hours := int(d % (24 * time.Hour) / time.Hour)
+	minutes := int(d % time.Hour / time.Minute)
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd%dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("%dh%dm", hours, minutes)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
+
+func writePullRequestStatsTable(w io.Writer, stats *pullRequestStats) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+
+	medianTimeToMerge := stats.MedianTimeToMerge
+	if medianTimeToMerge == "" {
+		medianTimeToMerge = "-"
+	}
+	fmt.Fprintf(tw, "Pull requests\t%d\n", stats.Total)
+	fmt.Fprintf(tw, "Merged\t%d\n", stats.Merged)
+	fmt.Fprintf(tw, "Median time to merge\t%s\n", medianTimeToMerge)
+	fmt.Fprintf(tw, "Additions / Deletions\t+%d / -%d\n", stats.Additions, stats.Deletions)
+	fmt.Fprintf(tw, "Review rounds\t%d (average %.2f)\n", stats.ReviewRounds, stats.AverageReviewRounds)
+
+	fmt.Fprint(tw, "\nREPOSITORY\tCOUNT\n")
+	for _, c := range stats.PerRepository {
+		fmt.Fprintf(tw, "%s\t%d\n", c.Name, c.Count)
+	}
+	fmt.Fprint(tw, "\nMONTH\tCOUNT\n")
+	for _, c := range stats.PerMonth {
+		fmt.Fprintf(tw, "%s\t%d\n", c.Name, c.Count)
+	}
+
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("could not flush tabwriter: %w", err)
+	}
+	return nil
+}
+
+func writePullRequestStatsChart(w io.Writer, stats *pullRequestStats) error {
+	var sb strings.Builder
+	sb.WriteString("Pull requests per repository\n")
+	writeBarChart(&sb, stats.PerRepository)
+	sb.WriteString("\nPull requests per month\n")
+	writeBarChart(&sb, stats.PerMonth)
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// writeBarChart writes the horizontal ASCII bar chart of counts to sb.
+func writeBarChart(sb *strings.Builder, counts []statsCount) {
+	maxName, maxCount := 0, 0
+	for _, c := range counts {
+		if len(c.Name) > maxName {
+			maxName = len(c.Name)
+		}
+		if c.Count > maxCount {
+			maxCount = c.Count
+		}
+	}
+
+	for _, c := range counts {
+		width := c.Count * statsChartWidth / maxCount
+		if width == 0 && c.Count > 0 {
+			width = 1
+		}
+		fmt.Fprintf(sb, "  %-*s %s %d\n", maxName, c.Name, strings.Repeat("#", width), c.Count)
+	}
+}
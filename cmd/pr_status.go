@@ -0,0 +1,253 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/google/go-github/v38/github"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/zchee/ghctl/pkg/config"
+	"github.com/zchee/ghctl/pkg/spin"
+)
+
+type pullRequestStatusCmd struct {
+	ioStreams *IOStreams
+
+	output     string
+	configFile string
+}
+
+func init() {
+	prCmd.AddCommand(newCmdPullRequestStatus())
+}
+
+func newCmdPullRequestStatus() *cobra.Command {
+	c := &pullRequestStatusCmd{
+		ioStreams: defaultIOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "status [owner|owner/repo]...",
+		Short: "Shows your open pull requests, pull requests awaiting your review and pull requests mentioning you",
+		Long: `Shows your open pull requests with the CI and review state, pull requests awaiting your review
+and pull requests mentioning you.
+
+The repositories to search are the arguments, or the status.repositories of the config file.
+If both are empty, searches the all repositories.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			return c.runStatus(ctx, args)
+		},
+		ValidArgsFunction: completeOwnersOrRepositoryNames,
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(&c.output, "output", "o", string(outputText), "output format. [text, json]")
+	f.StringVar(&c.configFile, "config", "", "path of the config file which has the status repositories (default: config.json in the config directory)")
+
+	return cmd
+}
+
+// pullRequestStatusItem represents the authored pull request with its CI and review state.
+type pullRequestStatusItem struct {
+	pullRequestListItem
+
+	Checks string `json:"checks"`
+	Review string `json:"review"`
+}
+
+// pullRequestStatus represents the result of the pr status command.
+type pullRequestStatus struct {
+	Created         []pullRequestStatusItem `json:"created"`
+	ReviewRequested []pullRequestListItem   `json:"review_requested"`
+	Mentioned       []pullRequestListItem   `json:"mentioned"`
+}
+
+func (c *pullRequestStatusCmd) runStatus(ctx context.Context, args []string) error {
+	format, err := parseOutputFormat(c.output, outputText, outputJSON)
+	if err != nil {
+		return err
+	}
+
+	repos := args
+	if len(repos) == 0 {
+		cfg, err := config.Load(c.configFile)
+		if err != nil {
+			return err
+		}
+		repos = cfg.Status.Repositories
+	}
+
+	client := newClient(ctx)
+
+	progress := spin.NewProgress(c.ioStreams.ErrOut)
+	searchTask := progress.AddTask("searching pull requests", 3)
+	progress.Start()
+
+	var status pullRequestStatus
+	var created []pullRequestListItem
+	searches := []struct {
+		search *pullRequestSearch
+		items  *[]pullRequestListItem
+	}{
+		{&pullRequestSearch{author: "@me", repos: repos, sort: "updated", order: "desc"}, &created},
+		{&pullRequestSearch{reviewRequested: "@me", repos: repos, sort: "updated", order: "desc"}, &status.ReviewRequested},
+		{&pullRequestSearch{mentions: "@me", repos: repos, sort: "updated", order: "desc"}, &status.Mentioned},
+	}
+
+	eg, egctx := errgroup.WithContext(ctx)
+	for _, s := range searches {
+		s := s
+		eg.Go(func() error {
+			items, err := searchPullRequests(egctx, client, s.search, pullRequestStateOpen)
+			if err != nil {
+				return err
+			}
+			*s.items = items
+			searchTask.Increment()
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		progress.Stop()
+		return err
+	}
+
+	status.Created, err = getPullRequestStatusItems(ctx, client, created, progress)
+	progress.Stop()
+	if err != nil {
+		return err
+	}
+
+	if format == outputJSON {
+		return writeJSON(c.ioStreams.Out, status)
+	}
+	return writePullRequestStatus(c.ioStreams.Out, &status)
+}
+
+// getPullRequestStatusItems fetches the CI and review state of items concurrently.
+func getPullRequestStatusItems(ctx context.Context, client *github.Client, items []pullRequestListItem, progress *spin.Progress) ([]pullRequestStatusItem, error) {
+	task := progress.AddTask("fetching checks and reviews", len(items))
+
+	statusItems := make([]pullRequestStatusItem, len(items))
+	eg, ctx := errgroup.WithContext(ctx)
+	sem := make(chan struct{}, 20) // for concurrency API access limit
+
+	for i, item := range items {
+		sem <- struct{}{}
+		i, item := i, item
+		eg.Go(func() error {
+			defer func() { <-sem }()
+
+			pr, resp, err := client.PullRequests.Get(ctx, item.Owner, item.Repo, item.Number)
+			if err != nil {
+				return fmt.Errorf("could not get %s/%s#%d pull request: %w", item.Owner, item.Repo, item.Number, IsRateLimitError(err))
+			}
+			progress.SetRateLimit(resp.Rate.Remaining, resp.Rate.Limit)
+
+			checks, err := listChecks(ctx, client, item.Owner, item.Repo, pr.GetHead().GetSHA())
+			if err != nil {
+				return err
+			}
+			reviewers, err := latestReviewerStates(ctx, client, pr)
+			if err != nil {
+				return err
+			}
+
+			checksState := "-"
+			if len(checks) > 0 {
+				state, _ := summarizeChecks(checks)
+				checksState = string(state)
+			}
+			reviewState := reviewDecision(reviewers)
+			if pr.GetDraft() {
+				reviewState = "draft"
+			}
+
+			statusItems[i] = pullRequestStatusItem{
+				pullRequestListItem: item,
+				Checks:              checksState,
+				Review:              reviewState,
+			}
+			task.Increment()
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return statusItems, nil
+}
+
+// reviewDecision returns the overall review state of reviewers.
+func reviewDecision(reviewers []reviewerState) string {
+	var approved, pending bool
+	for _, reviewer := range reviewers {
+		switch reviewer.State {
+		case "CHANGES_REQUESTED":
+			return "changes requested"
+		case "APPROVED":
+			approved = true
+		case "PENDING":
+			pending = true
+		}
+	}
+	switch {
+	case approved:
+		return "approved"
+	case pending:
+		return "review required"
+	default:
+		return "no reviews"
+	}
+}
+
+func writePullRequestStatus(w io.Writer, status *pullRequestStatus) error {
+	var sb strings.Builder
+	tw := tabwriter.NewWriter(&sb, 0, 8, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "Created by you")
+	if len(status.Created) == 0 {
+		fmt.Fprintln(tw, "  You have no open pull requests")
+	}
+	for _, item := range status.Created {
+		fmt.Fprintf(tw, "  %s/%s#%d\t%s\tchecks: %s\t%s\n", item.Owner, item.Repo, item.Number, item.Title, item.Checks, item.Review)
+	}
+
+	for _, section := range []struct {
+		title string
+		empty string
+		items []pullRequestListItem
+	}{
+		{"Requesting your review", "No pull requests awaiting your review", status.ReviewRequested},
+		{"Mentioning you", "No pull requests mentioning you", status.Mentioned},
+	} {
+		fmt.Fprintf(tw, "\n%s\n", section.title)
+		if len(section.items) == 0 {
+			fmt.Fprintf(tw, "  %s\n", section.empty)
+		}
+		for _, item := range section.items {
+			fmt.Fprintf(tw, "  %s/%s#%d\t%s\t%s\n", item.Owner, item.Repo, item.Number, item.Title, item.URL)
+		}
+	}
+
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("could not flush tabwriter: %w", err)
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
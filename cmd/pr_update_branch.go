@@ -0,0 +1,236 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/google/go-github/v38/github"
+	"github.com/spf13/cobra"
+
+	"github.com/zchee/ghctl/pkg/spin"
+)
+
+// updateBranchStatus represents the result of updating the pull request branch.
+type updateBranchStatus string
+
+const (
+	updateBranchUpdated  updateBranchStatus = "updated"
+	updateBranchUpToDate updateBranchStatus = "up to date"
+	updateBranchConflict updateBranchStatus = "conflict"
+	updateBranchFailed   updateBranchStatus = "failed"
+)
+
+type pullRequestUpdateBranchCmd struct {
+	ioStreams *IOStreams
+
+	all  bool
+	base string
+}
+
+func init() {
+	prCmd.AddCommand(newCmdPullRequestUpdateBranch())
+}
+
+func newCmdPullRequestUpdateBranch() *cobra.Command {
+	c := &pullRequestUpdateBranchCmd{
+		ioStreams: defaultIOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "update-branch <owner/repo> [<number>]",
+		Short: "Merges the latest base branch into the head branch of the pull request",
+		Long: `Merges the latest base branch into the head branch of the pull request.
+
+With --all, updates the all open pull requests which target the --base branch and are behind it,
+and reports the pull requests which have the conflicts to be resolved manually.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			if c.all {
+				if err := checkArgs(cmd, args, 1, exactArgs, "<owner/repo>"); err != nil {
+					return err
+				}
+				owner, repo, err := splitOwnerRepo(args[0])
+				if err != nil {
+					return err
+				}
+				return c.runUpdateAll(ctx, owner, repo)
+			}
+
+			if err := checkArgs(cmd, args, 2, exactArgs, "<owner/repo> <number>"); err != nil {
+				return err
+			}
+			if c.base != "" {
+				return errors.New("--base flag requires --all flag")
+			}
+			owner, repo, number, err := parseRepositoryNumber(args)
+			if err != nil {
+				return err
+			}
+			return c.runUpdate(ctx, owner, repo, number)
+		},
+		Annotations:       noPagerAnnotation(),
+		ValidArgsFunction: completeRepositoryPullRequest,
+	}
+
+	f := cmd.Flags()
+	f.BoolVar(&c.all, "all", false, "update the all open pull requests behind the base branch")
+	f.StringVar(&c.base, "base", "", "base branch of the pull requests to update with --all (default: default branch of the repository)")
+
+	return cmd
+}
+
+// updateBranchResult represents the result of updating the pull request branch.
+type updateBranchResult struct {
+	pr     *github.PullRequest
+	status updateBranchStatus
+	err    error
+}
+
+func (c *pullRequestUpdateBranchCmd) runUpdate(ctx context.Context, owner, repo string, number int) error {
+	client := newClient(ctx)
+
+	pr, _, err := client.PullRequests.Get(ctx, owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("could not get %s/%s#%d pull request: %w", owner, repo, number, IsRateLimitError(err))
+	}
+	if pr.GetState() != "open" {
+		return fmt.Errorf("%s/%s#%d is not open", owner, repo, number)
+	}
+
+	result := updatePullRequestBranch(ctx, client, owner, repo, pr)
+	switch result.status {
+	case updateBranchConflict:
+		return fmt.Errorf("%s has conflicts with %s, resolve them manually", pr.GetHTMLURL(), pr.GetBase().GetRef())
+	case updateBranchFailed:
+		return result.err
+	}
+
+	fmt.Fprintf(c.ioStreams.ErrOut, "%s: %s\n", pr.GetHTMLURL(), result.status)
+	return nil
+}
+
+func (c *pullRequestUpdateBranchCmd) runUpdateAll(ctx context.Context, owner, repo string) error {
+	client := newClient(ctx)
+
+	base := c.base
+	if base == "" {
+		repository, _, err := client.Repositories.Get(ctx, owner, repo)
+		if err != nil {
+			return fmt.Errorf("could not get %s/%s repository: %w", owner, repo, IsRateLimitError(err))
+		}
+		base = repository.GetDefaultBranch()
+	}
+
+	progress := spin.NewProgress(c.ioStreams.ErrOut)
+	task := progress.AddTask("updating pull request branches", 0)
+	progress.Start()
+
+	prs, err := listPullRequests(ctx, client, owner, repo, &github.PullRequestListOptions{
+		State:       "open",
+		Base:        base,
+		ListOptions: github.ListOptions{PerPage: 100},
+	})
+	if err != nil {
+		progress.Stop()
+		return err
+	}
+	task.SetTotal(len(prs))
+
+	results := make([]updateBranchResult, len(prs))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, 20) // for concurrency API access limit
+	for i, pr := range prs {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, pr *github.PullRequest) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			results[i] = updatePullRequestBranch(ctx, client, owner, repo, pr)
+			task.Increment()
+		}(i, pr)
+	}
+	wg.Wait()
+	progress.Stop()
+
+	if len(results) == 0 {
+		fmt.Fprintf(c.ioStreams.ErrOut, "no open pull requests target %s\n", base)
+		return nil
+	}
+
+	if err := writeUpdateBranchResults(c.ioStreams.Out, results); err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, result := range results {
+		if result.status == updateBranchConflict || result.status == updateBranchFailed {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d pull requests need manual work", failed, len(results))
+	}
+	return nil
+}
+
+// updatePullRequestBranch merges the base branch into the head branch of pr if it is behind the base.
+func updatePullRequestBranch(ctx context.Context, client *github.Client, owner, repo string, pr *github.PullRequest) updateBranchResult {
+	result := updateBranchResult{pr: pr}
+
+	head := pr.GetHead().GetSHA()
+	comparison, _, err := client.Repositories.CompareCommits(ctx, owner, repo, pr.GetBase().GetRef(), head, nil)
+	if err != nil {
+		result.status, result.err = updateBranchFailed, fmt.Errorf("could not compare %s...%.7s: %w", pr.GetBase().GetRef(), head, IsRateLimitError(err))
+		return result
+	}
+	if comparison.GetBehindBy() == 0 {
+		result.status = updateBranchUpToDate
+		return result
+	}
+
+	_, resp, err := client.PullRequests.UpdateBranch(ctx, owner, repo, pr.GetNumber(), &github.PullRequestBranchUpdateOptions{
+		ExpectedHeadSHA: github.String(head),
+	})
+	var accepted *github.AcceptedError
+	switch {
+	case err == nil, errors.As(err, &accepted):
+		// the update is scheduled in the background
+		result.status = updateBranchUpdated
+	case resp != nil && resp.StatusCode == http.StatusUnprocessableEntity:
+		// the merge conflict, or the head is pushed after the comparison
+		result.status, result.err = updateBranchConflict, err
+	default:
+		result.status, result.err = updateBranchFailed, fmt.Errorf("could not update branch: %w", IsRateLimitError(err))
+	}
+
+	return result
+}
+
+func writeUpdateBranchResults(w io.Writer, results []updateBranchResult) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	for _, result := range results {
+		status := string(result.status)
+		if result.status == updateBranchFailed {
+			status += ": " + result.err.Error()
+		}
+		fmt.Fprintf(tw, "#%d\t%s\t%s\t%s\n", result.pr.GetNumber(), status, result.pr.GetHead().GetLabel(), result.pr.GetTitle())
+	}
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("could not flush tabwriter: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,289 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/go-github/v38/github"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+type pullRequestViewCmd struct {
+	ioStreams *IOStreams
+
+	output string
+}
+
+func init() {
+	prCmd.AddCommand(newCmdPullRequestView())
+}
+
+func newCmdPullRequestView() *cobra.Command {
+	c := &pullRequestViewCmd{
+		ioStreams: defaultIOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view <owner/repo> <number>",
+		Short: "Shows the description, reviewers, checks, labels and mergeability of the pull request",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := checkArgs(cmd, args, 2, exactArgs, "<owner/repo> <number>"); err != nil {
+				return err
+			}
+			owner, repo, number, err := parseRepositoryNumber(args)
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			return c.runView(ctx, owner, repo, number)
+		},
+		ValidArgsFunction: completeRepositoryPullRequest,
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(&c.output, "output", "o", string(outputText), "output format. [text, json]")
+
+	return cmd
+}
+
+// reviewerState represents the latest review state of the reviewer.
+type reviewerState struct {
+	Login string `json:"login"`
+	State string `json:"state"`
+}
+
+// pullRequestView represents the pull request of the pr view command.
+type pullRequestView struct {
+	Owner     string          `json:"owner"`
+	Repo      string          `json:"repo"`
+	Number    int             `json:"number"`
+	Title     string          `json:"title"`
+	URL       string          `json:"url"`
+	State     string          `json:"state"`
+	Draft     bool            `json:"draft"`
+	Author    string          `json:"author"`
+	Base      string          `json:"base"`
+	Head      string          `json:"head"`
+	CreatedAt time.Time       `json:"created_at"`
+	Body      string          `json:"body"`
+	Labels    []string        `json:"labels"`
+	Reviewers []reviewerState `json:"reviewers"`
+	Checks    []checkResult   `json:"checks"`
+	Mergeable string          `json:"mergeable"`
+	Additions int             `json:"additions"`
+	Deletions int             `json:"deletions"`
+	Files     int             `json:"changed_files"`
+}
+
+func (c *pullRequestViewCmd) runView(ctx context.Context, owner, repo string, number int) error {
+	format, err := parseOutputFormat(c.output, outputText, outputJSON)
+	if err != nil {
+		return err
+	}
+
+	client := newClient(ctx)
+
+	pr, _, err := client.PullRequests.Get(ctx, owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("could not get %s/%s#%d pull request: %w", owner, repo, number, IsRateLimitError(err))
+	}
+
+	var reviewers []reviewerState
+	var checks []checkResult
+	eg, egctx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		var err error
+		reviewers, err = latestReviewerStates(egctx, client, pr)
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		checks, err = listChecks(egctx, client, owner, repo, pr.GetHead().GetSHA())
+		return err
+	})
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	view := newPullRequestView(owner, repo, pr, reviewers, checks)
+
+	if format == outputJSON {
+		return writeJSON(c.ioStreams.Out, view)
+	}
+	return writePullRequestView(c.ioStreams.Out, view)
+}
+
+// newPullRequestView returns the pullRequestView of pr.
+func newPullRequestView(owner, repo string, pr *github.PullRequest, reviewers []reviewerState, checks []checkResult) *pullRequestView {
+	state := pr.GetState()
+	if pr.GetMerged() {
+		state = "merged"
+	}
+	labels := make([]string, len(pr.Labels))
+	for i, label := range pr.Labels {
+		labels[i] = label.GetName()
+	}
+
+	return &pullRequestView{
+		Owner:     owner,
+		Repo:      repo,
+		Number:    pr.GetNumber(),
+		Title:     pr.GetTitle(),
+		URL:       pr.GetHTMLURL(),
+		State:     state,
+		Draft:     pr.GetDraft(),
+		Author:    pr.GetUser().GetLogin(),
+		Base:      pr.GetBase().GetRef(),
+		Head:      pr.GetHead().GetLabel(),
+		CreatedAt: pr.GetCreatedAt(),
+		Body:      pr.GetBody(),
+		Labels:    labels,
+		Reviewers: reviewers,
+		Checks:    checks,
+		Mergeable: mergeableState(pr),
+		Additions: pr.GetAdditions(),
+		Deletions: pr.GetDeletions(),
+		Files:     pr.GetChangedFiles(),
+	}
+}
+
+// mergeableState returns the human readable mergeability of pr.
+func mergeableState(pr *github.PullRequest) string {
+	if pr.GetMerged() || pr.GetState() == "closed" {
+		return "-"
+	}
+	if pr.Mergeable == nil {
+		// GitHub computes the mergeability in the background
+		return "unknown"
+	}
+	if !pr.GetMergeable() {
+		return "conflicting"
+	}
+	switch state := pr.GetMergeableState(); state {
+	case "clean", "":
+		return "mergeable"
+	default: // behind, blocked, unstable, has_hooks, draft
+		return "mergeable (" + state + ")"
+	}
+}
+
+// latestReviewerStates returns the latest review state of each reviewer of pr, and the requested reviewers as PENDING.
+// The COMMENTED review does not override the previous APPROVED or CHANGES_REQUESTED review.
+func latestReviewerStates(ctx context.Context, client *github.Client, pr *github.PullRequest) ([]reviewerState, error) {
+	owner, repo := pr.GetBase().GetRepo().GetOwner().GetLogin(), pr.GetBase().GetRepo().GetName()
+	author := pr.GetUser().GetLogin()
+
+	states := make(map[string]string)
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		reviews, resp, err := client.PullRequests.ListReviews(ctx, owner, repo, pr.GetNumber(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("could not list %s/%s#%d reviews: %w", owner, repo, pr.GetNumber(), IsRateLimitError(err))
+		}
+		for _, review := range reviews {
+			login := review.GetUser().GetLogin()
+			if login == author {
+				continue
+			}
+			switch state := review.GetState(); state {
+			case "PENDING":
+			case "COMMENTED":
+				if _, ok := states[login]; !ok {
+					states[login] = state
+				}
+			default:
+				states[login] = state
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	// the re-requested reviewer is pending regardless of the previous review
+	for _, user := range pr.RequestedReviewers {
+		states[user.GetLogin()] = "PENDING"
+	}
+	for _, team := range pr.RequestedTeams {
+		states[owner+"/"+team.GetSlug()] = "PENDING"
+	}
+
+	reviewers := make([]reviewerState, 0, len(states))
+	for login, state := range states {
+		reviewers = append(reviewers, reviewerState{Login: login, State: state})
+	}
+	sort.Slice(reviewers, func(i, j int) bool { return reviewers[i].Login < reviewers[j].Login })
+
+	return reviewers, nil
+}
+
+func writePullRequestView(w io.Writer, view *pullRequestView) error {
+	var sb strings.Builder
+
+	state := view.State
+	if view.Draft {
+		state += " (draft)"
+	}
+	fmt.Fprintf(&sb, "%s #%d\n", view.Title, view.Number)
+	fmt.Fprintf(&sb, "%s • %s wants to merge into %s from %s • %s\n", state, view.Author, view.Base, view.Head, view.CreatedAt.Format(time.RFC3339))
+	fmt.Fprintf(&sb, "%s\n\n", view.URL)
+
+	tw := tabwriter.NewWriter(&sb, 0, 8, 2, ' ', 0)
+	labels := "-"
+	if len(view.Labels) > 0 {
+		labels = strings.Join(view.Labels, ", ")
+	}
+	fmt.Fprintf(tw, "Labels:\t%s\n", labels)
+	fmt.Fprintf(tw, "Mergeable:\t%s\n", view.Mergeable)
+	fmt.Fprintf(tw, "Changes:\t+%d -%d in %d files\n", view.Additions, view.Deletions, view.Files)
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("could not flush tabwriter: %w", err)
+	}
+
+	sb.WriteString("\nReviewers:\n")
+	if len(view.Reviewers) == 0 {
+		sb.WriteString("  -\n")
+	}
+	tw = tabwriter.NewWriter(&sb, 0, 8, 2, ' ', 0)
+	for _, reviewer := range view.Reviewers {
+		fmt.Fprintf(tw, "  %s\t%s\n", reviewer.Login, strings.ToLower(strings.ReplaceAll(reviewer.State, "_", " ")))
+	}
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("could not flush tabwriter: %w", err)
+	}
+
+	sb.WriteString("\nChecks:\n")
+	if len(view.Checks) == 0 {
+		sb.WriteString("  -\n")
+	}
+	tw = tabwriter.NewWriter(&sb, 0, 8, 2, ' ', 0)
+	for _, check := range view.Checks {
+		fmt.Fprintf(tw, "  %s\t%s\t%s\n", check.State, check.Name, check.URL)
+	}
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("could not flush tabwriter: %w", err)
+	}
+
+	body := strings.TrimSpace(view.Body)
+	if body == "" {
+		body = "No description provided."
+	}
+	fmt.Fprintf(&sb, "\n%s\n", body)
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
@@ -37,8 +37,8 @@ func runRateLimit(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
-	fmt.Printf("Your rate limit: %d, Remaining: %d\n", rateLimit.Core.Limit, rateLimit.Core.Remaining)
-	fmt.Printf("Reset time: %v", rateLimit.Core.Reset)
+	fmt.Fprintf(defaultIOStreams.Out, "Your rate limit: %d, Remaining: %d\n", rateLimit.Core.Limit, rateLimit.Core.Remaining)
+	fmt.Fprintf(defaultIOStreams.Out, "Reset time: %v", rateLimit.Core.Reset)
 
 	return nil
 }
@@ -35,8 +35,9 @@ var (
 	}
 
 	releaseDeleteCmd = &cobra.Command{
-		Use:   "delete",
-		Short: "Delete any repository release",
+		Use:         "delete",
+		Short:       "Delete any repository release",
+		Annotations: noPagerAnnotation(),
 		Run: func(cmd *cobra.Command, args []string) {
 			if err := runReleaseDelete(cmd, args); err != nil {
 				cmd.Println(err)
@@ -86,7 +87,7 @@ func runReleaseCreate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed: %w", err)
 	}
 
-	fmt.Fprintf(os.Stdout, "Created %s release\n", tag)
+	fmt.Fprintf(defaultIOStreams.Out, "Created %s release\n", tag)
 
 	return nil
 }
@@ -129,7 +130,7 @@ func runReleaseDelete(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("could not delete %s release to %s/%s: %w", tag, owner, repo, checkRateLimitError(err))
 	}
 
-	fmt.Fprintf(os.Stdout, "Deleted %s release\n", tag)
+	fmt.Fprintf(defaultIOStreams.Out, "Deleted %s release\n", tag)
 
 	if releaseDeleteWithTag {
 		resp, err := client.Git.DeleteRef(ctx, owner, repo, fmt.Sprintf("tags/%s", tag))
@@ -139,7 +140,7 @@ func runReleaseDelete(cmd *cobra.Command, args []string) error {
 		if resp.StatusCode != http.StatusOK {
 			return fmt.Errorf("failed: %w", err)
 		}
-		fmt.Fprintf(os.Stdout, "Deleted %s tag\n", tag)
+		fmt.Fprintf(defaultIOStreams.Out, "Deleted %s tag\n", tag)
 	}
 
 	return nil
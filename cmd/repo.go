@@ -5,15 +5,15 @@
 package cmd
 
 import (
-	"bufio"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/google/go-github/v38/github"
@@ -22,6 +22,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	gherrors "github.com/zchee/ghctl/pkg/errors"
+	"github.com/zchee/ghctl/pkg/report"
 	"github.com/zchee/ghctl/pkg/spin"
 )
 
@@ -42,8 +43,9 @@ var (
 		},
 	}
 	repoDeleteCmd = &cobra.Command{
-		Use:   "delete",
-		Short: "Delete repository",
+		Use:         "delete [repository]",
+		Short:       "Delete repository. If [repository] is empty, pick repositories interactively",
+		Annotations: noPagerAnnotation(),
 		Run: func(cmd *cobra.Command, args []string) {
 			if err := runRepoDelete(cmd, args); err != nil {
 				fmt.Fprint(cmd.OutOrStderr(), err)
@@ -51,8 +53,9 @@ var (
 		},
 	}
 	repoOpenCmd = &cobra.Command{
-		Use:   "open",
-		Short: "Open repository",
+		Use:         "open [username/repository]",
+		Short:       "Open repository. If [username/repository] is empty, pick repositories interactively",
+		Annotations: noPagerAnnotation(),
 		Run: func(cmd *cobra.Command, args []string) {
 			if err := runRepoOpen(cmd, args); err != nil {
 				fmt.Fprint(cmd.OutOrStderr(), err)
@@ -60,8 +63,9 @@ var (
 		},
 	}
 	repoCollaboratorCmd = &cobra.Command{
-		Use:   "collaborator",
-		Short: "manage repository's collaborators.",
+		Use:         "collaborator [owner/repository]",
+		Short:       "manage repository's collaborators. If [owner/repository] is empty, pick repositories interactively",
+		Annotations: noPagerAnnotation(),
 		Run: func(cmd *cobra.Command, args []string) {
 			if err := runRepoCollaborator(cmd, args); err != nil {
 				fmt.Fprint(cmd.OutOrStderr(), err)
@@ -83,6 +87,7 @@ type repoFlags struct {
 	typ           string
 	affiliation   string
 	includeForked bool
+	output        string
 
 	collaborator string
 
@@ -100,6 +105,7 @@ func init() {
 	repoListCmd.Flags().StringVarP(&flags.typ, "type", "t", "all", "Type of repositories to list. Default: all [all, owner, public, private, member]")
 	repoListCmd.Flags().StringVarP(&flags.affiliation, "affiliation", "a", "", "Comma separated list repos of given affiliation[s]. [owner,collaborator,organization_member]")
 	repoListCmd.Flags().BoolVar(&flags.includeForked, "forked", false, "include forked repository.")
+	repoListCmd.Flags().StringVarP(&flags.output, "output", "o", string(outputText), "output format. [text, json, html]")
 
 	repoCmd.AddCommand(repoDeleteCmd)
 
@@ -112,18 +118,50 @@ func init() {
 	repoAcceptInvitationCmd.Flags().StringVar(&flags.acceptUserToken, "token", "", "GitHub TOKEN for accepting user is different.")
 }
 
+// repoListItem represents the repository of the repo list command result.
+type repoListItem struct {
+	FullName    string    `json:"full_name"`
+	Owner       string    `json:"owner"`
+	Name        string    `json:"name"`
+	URL         string    `json:"url"`
+	Description string    `json:"description"`
+	Language    string    `json:"language"`
+	Stars       int       `json:"stars"`
+	Forks       int       `json:"forks"`
+	Fork        bool      `json:"fork"`
+	Private     bool      `json:"private"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func newRepoListItem(repo *github.Repository) repoListItem {
+	return repoListItem{
+		FullName:    repo.GetFullName(),
+		Owner:       repo.GetOwner().GetLogin(),
+		Name:        repo.GetName(),
+		URL:         repo.GetHTMLURL(),
+		Description: repo.GetDescription(),
+		Language:    repo.GetLanguage(),
+		Stars:       repo.GetStargazersCount(),
+		Forks:       repo.GetForksCount(),
+		Fork:        repo.GetFork(),
+		Private:     repo.GetPrivate(),
+		UpdatedAt:   repo.GetUpdatedAt().Time,
+	}
+}
+
 func runRepoList(cmd *cobra.Command, args []string) error {
+	format, err := parseOutputFormat(flags.output, outputText, outputJSON, outputHTML)
+	if err != nil {
+		return err
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
 	client := newClient(ctx)
-	s := spin.NewSpin()
 
 	opts := github.RepositoryListOptions{
 		Type: flags.typ,
-		ListOptions: github.ListOptions{
-			Page: 1,
-		},
 	}
 	switch flags.typ {
 	case "public", "private":
@@ -138,125 +176,210 @@ func runRepoList(cmd *cobra.Command, args []string) error {
 	if len(args) > 0 {
 		repoName = args[0]
 	}
+
+	progress := spin.NewProgress(os.Stderr)
+	progress.Start()
+	repos, err := listRepositories(ctx, client, repoName, opts, flags.includeForked, progress)
+	progress.Stop()
+	if err != nil {
+		return err
+	}
+
+	items := make([]repoListItem, len(repos))
+	for i, repo := range repos {
+		items[i] = newRepoListItem(repo)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].URL < items[j].URL })
+
+	return writeRepoList(defaultIOStreams.Out, format, items)
+}
+
+// writeRepoList writes the items to w with format.
+func writeRepoList(w io.Writer, format outputFormat, items []repoListItem) error {
+	switch format {
+	case outputJSON:
+		return writeJSON(w, items)
+
+	case outputHTML:
+		return repoListReport(items).WriteHTML(w)
+
+	default:
+		urls := make([]string, len(items))
+		for i, item := range items {
+			urls[i] = item.URL
+		}
+		_, err := fmt.Fprint(w, strings.Join(urls, "\n"))
+		return err
+	}
+}
+
+// repoListReport returns the HTML report of items grouped by owner.
+func repoListReport(items []repoListItem) *report.Report {
+	r := &report.Report{
+		Title:   "Repositories",
+		Columns: []string{"Repository", "Description", "Language", "Stars", "Forks", "Updated"},
+	}
+
+	groups := make(map[string]int)
+	var public, private, forked int
+	for _, item := range items {
+		i, ok := groups[item.Owner]
+		if !ok {
+			i = len(r.Groups)
+			groups[item.Owner] = i
+			r.Groups = append(r.Groups, report.Group{
+				Name: item.Owner,
+				Link: "https://github.com/" + item.Owner,
+			})
+		}
+		r.Groups[i].Rows = append(r.Groups[i].Rows, []report.Cell{
+			{Text: item.Name, Link: item.URL},
+			{Text: item.Description},
+			{Text: item.Language},
+			{Text: strconv.Itoa(item.Stars)},
+			{Text: strconv.Itoa(item.Forks)},
+			{Text: item.UpdatedAt.Format("2006-01-02"), SortKey: item.UpdatedAt.Format(time.RFC3339)},
+		})
+
+		if item.Private {
+			private++
+		} else {
+			public++
+		}
+		if item.Fork {
+			forked++
+		}
+	}
+	sort.Slice(r.Groups, func(i, j int) bool { return r.Groups[i].Name < r.Groups[j].Name })
+
+	r.Summary = []report.Count{
+		{Label: "Public", Count: public},
+		{Label: "Private", Count: private},
+		{Label: "Forked", Count: forked},
+	}
+
+	return r
+}
+
+// listRepositories lists the repositories of username concurrently.
+// If username is empty, lists the authenticated user repositories.
+func listRepositories(ctx context.Context, client *github.Client, username string, opts github.RepositoryListOptions, includeForked bool, progress *spin.Progress) ([]*github.Repository, error) {
+	filter := func(repos []*github.Repository) []*github.Repository {
+		repos2 := make([]*github.Repository, 0, len(repos))
+		for _, repo := range repos {
+			if repo.GetFork() && !includeForked {
+				continue
+			}
+			repos2 = append(repos2, repo)
+		}
+		return repos2
+	}
+
 	// pre-fetch page 1 for the get LastPage size
-	firstRepos, firstResp, err := client.Repositories.List(ctx, repoName, &opts)
+	opts.Page = 1
+	firstRepos, firstResp, err := client.Repositories.List(ctx, username, &opts)
 	if err != nil {
 		if gherrors.IsRateLimitErr(err) {
-			return errors.New("repo: hit GitHub API rate limit")
+			return nil, errors.New("repo: hit GitHub API rate limit")
 		}
-		if ctx.Err() != nil {
-			return nil
-		}
-		return fmt.Errorf("repo: could not get list all repositories: %w", err)
+		return nil, fmt.Errorf("repo: could not get list all repositories: %w", err)
 	}
 
 	lastPage := firstResp.LastPage
 	if lastPage == 0 {
-		return fmt.Errorf("repo: %s user have not %q repository", repoName, flags.typ)
-	}
-
-	repos2 := make([]*github.Repository, 0, len(firstRepos))
-	for _, repo := range firstRepos {
-		if repo.GetFork() && !flags.includeForked {
-			continue
+		if len(firstRepos) == 0 {
+			return nil, fmt.Errorf("repo: %s user have not %q repository", username, opts.Type)
 		}
-		repos2 = append(repos2, repo)
+		lastPage = 1 // only one page
 	}
 
+	task := progress.AddTask(spin.FetchMsg, lastPage)
+	task.Increment()
+	progress.SetRateLimit(firstResp.Rate.Remaining, firstResp.Rate.Limit)
+
 	// make lastPage size chan for parallel fetch
-	reposCh := make(chan []string, lastPage)
-	uris := make([]string, len(repos2))
-	for i, repo := range repos2 {
-		uris[i] = repo.GetHTMLURL()
-	}
-	reposCh <- uris
-	s.Next(spin.FetchMsg, fmt.Sprintf("page: %d/%d", 0, lastPage))
+	reposCh := make(chan []*github.Repository, lastPage)
+	reposCh <- filter(firstRepos)
 
-	wg := new(sync.WaitGroup)
-	wg.Add(lastPage - 1)
+	eg, ctx := errgroup.WithContext(ctx)
 	sem := make(chan struct{}, 20)
-	errs := make(chan error)
 
 	// alloc i to 1 because already fetched page 1
 	for i := 1; i < lastPage; i++ {
 		sem <- struct{}{}
-		go func(opts github.RepositoryListOptions, i int) {
-			defer func() {
-				<-sem
-				wg.Done()
-			}()
-
-			opts.Page = i + 1 // paging is based 1
-			repos, _, err := client.Repositories.List(ctx, repoName, &opts)
+		opts := opts      // copy
+		opts.Page = i + 1 // paging is based 1
+		eg.Go(func() error {
+			defer func() { <-sem }()
+
+			repos, resp, err := client.Repositories.List(ctx, username, &opts)
 			if err != nil {
 				if gherrors.IsRateLimitErr(err) {
-					errs <- gherrors.ErrRateLimit
-					return
-				}
-				errs <- fmt.Errorf("repo: could not get list all repositories: %w", err)
-				return
-			}
-			repos2 := make([]*github.Repository, 0, len(repos))
-			for _, repo := range repos {
-				if repo.GetFork() && !flags.includeForked {
-					continue
+					return gherrors.ErrRateLimit
 				}
-				repos2 = append(repos2, repo)
+				return fmt.Errorf("repo: could not get list all repositories: %w", err)
 			}
 
-			urls := make([]string, len(repos2))
-			for j, repo := range repos2 {
-				urls[j] = repo.GetHTMLURL()
-			}
-			reposCh <- urls
-			s.Next(spin.FetchMsg, fmt.Sprintf("page: %d/%d", len(reposCh), lastPage))
-		}(opts, i)
+			reposCh <- filter(repos)
+			task.Increment()
+			progress.SetRateLimit(resp.Rate.Remaining, resp.Rate.Limit)
+			return nil
+		})
 	}
 
-	go func() {
-		wg.Wait()
-		close(reposCh)
-		s.Flush()
-	}()
-
-	if len(errs) != 0 {
-		return <-errs
+	if err := eg.Wait(); err != nil {
+		return nil, err
 	}
+	close(reposCh)
 
-	var repos []string
+	var repos []*github.Repository
 	for r := range reposCh {
 		repos = append(repos, r...)
 	}
-	sort.Strings(repos)
-
-	fmt.Print(strings.Join(repos, "\n"))
 
-	return nil
+	return repos, nil
 }
 
 func runRepoDelete(cmd *cobra.Command, args []string) error {
-	if err := checkArgs(cmd, args, 1, exactArgs, "<repository>"); err != nil {
-		return err
-	}
-	repoDeleteName := args[0]
-
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
 	client := newClient(ctx)
 	s := spin.NewSpin()
+
+	repoDeleteNames := args
+	if len(args) == 0 && canPrompt(defaultIOStreams) {
+		names, err := pickRepositories(ctx, client, defaultIOStreams, true)
+		if err != nil {
+			return err
+		}
+		repoDeleteNames = names
+	} else if err := checkArgs(cmd, args, 1, exactArgs, "<repository>"); err != nil {
+		return err
+	}
+
 	user, err := getUser(ctx, client)
 	if err != nil {
 		return fmt.Errorf("could not get user information: %w", err)
 	}
 
-	fmt.Printf("remove repository %q? (y,n) ", repoDeleteName)
-	r := bufio.NewReader(os.Stdin)
-	confirm, err := r.ReadString('\n')
+	// list the every full name, since the picked repositories can be owned by the other owners
+	for i, name := range repoDeleteNames {
+		if !strings.Contains(name, "/") {
+			repoDeleteNames[i] = user.GetLogin() + "/" + name
+		}
+		fmt.Fprintf(defaultIOStreams.ErrOut, "  %s\n", repoDeleteNames[i])
+	}
+	var ok bool
+	if len(repoDeleteNames) > 1 {
+		ok, err = confirmCount(defaultIOStreams, fmt.Sprintf("remove the %d repositories above?", len(repoDeleteNames)), len(repoDeleteNames))
+	} else {
+		ok, err = confirm(defaultIOStreams, "remove the repository above?")
+	}
 	if err != nil {
 		return err
 	}
-	if strings.TrimSpace(confirm) != "y" {
+	if !ok {
 		return errors.New("cancelled")
 	}
 	done := make(chan struct{}, 1)
@@ -271,43 +394,60 @@ func runRepoDelete(cmd *cobra.Command, args []string) error {
 			}
 		}
 	}()
-	_, err = client.Repositories.Delete(ctx, user.GetLogin(), repoDeleteName)
+	for _, repoDeleteName := range repoDeleteNames {
+		i := strings.IndexByte(repoDeleteName, '/')
+		owner, repo := repoDeleteName[:i], repoDeleteName[i+1:]
+		if _, err = client.Repositories.Delete(ctx, owner, repo); err != nil {
+			err = fmt.Errorf("could not delete %s repository: %w", repoDeleteName, err)
+			break
+		}
+	}
 	done <- struct{}{}
 	s.Flush()
-	if err != nil {
-		return fmt.Errorf("could not delete %s repository: %w", repoDeleteName, err)
+
+	// the repositories are deleted, so the stale cache is only the warning
+	if err := forgetUserRepositories(ctx, client); err != nil {
+		warnCache(err)
 	}
 
-	return nil
+	return err
 }
 
 func runRepoOpen(cmd *cobra.Command, args []string) error {
-	if err := checkArgs(cmd, args, 1, exactArgs, "<username/repository>"); err != nil {
-		return err
-	}
-	repoOpenName := args[0]
-
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
 	client := newClient(ctx)
 
-	if !strings.Contains(repoOpenName, "/") {
-		user, err := getUser(ctx, client)
+	repoOpenNames := args
+	if len(args) == 0 && canPrompt(defaultIOStreams) {
+		names, err := pickRepositories(ctx, client, defaultIOStreams, true)
 		if err != nil {
-			return fmt.Errorf("could not get user information: %w", err)
+			return err
 		}
-		repoOpenName = fmt.Sprintf("%s/%s", user.GetLogin(), repoOpenName)
+		repoOpenNames = names
+	} else if err := checkArgs(cmd, args, 1, exactArgs, "<username/repository>"); err != nil {
+		return err
 	}
 
-	u := fmt.Sprintf("https://github.com/%s", repoOpenName)
-	resp, err := http.Get(u)
-	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("failed http request: %s", u)
-	}
+	for _, repoOpenName := range repoOpenNames {
+		if !strings.Contains(repoOpenName, "/") {
+			user, err := getUser(ctx, client)
+			if err != nil {
+				return fmt.Errorf("could not get user information: %w", err)
+			}
+			repoOpenName = fmt.Sprintf("%s/%s", user.GetLogin(), repoOpenName)
+		}
+
+		u := fmt.Sprintf("https://github.com/%s", repoOpenName)
+		resp, err := http.Get(u)
+		if err != nil || resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("failed http request: %s", u)
+		}
 
-	if err := browser.OpenURL(u); err != nil {
-		return fmt.Errorf("could not open %s url: %w", u, err)
+		if err := browser.OpenURL(u); err != nil {
+			return fmt.Errorf("could not open %s url: %w", u, err)
+		}
 	}
 
 	return nil
@@ -317,27 +457,40 @@ func runRepoCollaborator(cmd *cobra.Command, args []string) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	if err := checkArgs(cmd, args, 1, exactArgs, "<owner/repository>"); err != nil {
-		return err
-	}
-	ss := strings.Split(args[0], "/")
-	owner := ss[0]
-	repo := ss[1]
 	if flags.collaborator == "" {
 		return errors.New("--collaborator flag must be not empty")
 	}
 	collaborator := flags.collaborator
 
 	client := newClient(ctx)
-	inv, resp, err := client.Repositories.AddCollaborator(ctx, owner, repo, collaborator, &github.RepositoryAddCollaboratorOptions{Permission: "admin"})
-	if err != nil {
-		return fmt.Errorf("repo: could not get list all repositories: %w", IsRateLimitError(err))
-	}
-	if resp.StatusCode == http.StatusNoContent {
-		return fmt.Errorf("%s user already collaborator on %s/%s", collaborator, owner, repo)
+
+	fullnames := args
+	if len(args) == 0 && canPrompt(defaultIOStreams) {
+		names, err := pickRepositories(ctx, client, defaultIOStreams, true)
+		if err != nil {
+			return err
+		}
+		fullnames = names
+	} else if err := checkArgs(cmd, args, 1, exactArgs, "<owner/repository>"); err != nil {
+		return err
 	}
 
-	fmt.Fprintf(os.Stdout, "added %s user to %s/%s collaborator\n\tid: %d", collaborator, owner, repo, inv.GetID())
+	for _, fullname := range fullnames {
+		owner, repo, err := splitOwnerRepo(fullname)
+		if err != nil {
+			return err
+		}
+
+		inv, resp, err := client.Repositories.AddCollaborator(ctx, owner, repo, collaborator, &github.RepositoryAddCollaboratorOptions{Permission: "admin"})
+		if err != nil {
+			return fmt.Errorf("repo: could not get list all repositories: %w", IsRateLimitError(err))
+		}
+		if resp.StatusCode == http.StatusNoContent {
+			return fmt.Errorf("%s user already collaborator on %s/%s", collaborator, owner, repo)
+		}
+
+		fmt.Fprintf(defaultIOStreams.Out, "added %s user to %s/%s collaborator\n\tid: %d\n", collaborator, owner, repo, inv.GetID())
+	}
 
 	return nil
 }
@@ -435,7 +588,7 @@ func runRepoAcceptInvitation(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("repo: failed to accept %d invitation: status: %s", invID, http.StatusText(code))
 	}
 
-	fmt.Fprintf(os.Stdout, "accepted %d invitation ID from %s repository\n", invID, fullname)
+	fmt.Fprintf(defaultIOStreams.Out, "accepted %d invitation ID from %s repository\n", invID, fullname)
 
 	return nil
 }
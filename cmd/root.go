@@ -11,17 +11,47 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// annotationNoPager is the command annotation key to disable the pager, such as the interactive commands.
+const annotationNoPager = "ghctl/no-pager"
+
 // rootCmd represents the base command when called without any subcommands.
 var rootCmd = &cobra.Command{
 	Use:   "ghctl",
 	Short: "A CLI tool for GitHub repositories",
+	// the runtime errors are not the usage errors, and Execute prints the error once
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if noPager || cmd.Annotations[annotationNoPager] == "true" {
+			return nil
+		}
+		return defaultIOStreams.StartPager()
+	},
+}
+
+var (
+	noPager bool
+)
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&noPager, "no-pager", false, "do not pipe output into the pager ($GHCTL_PAGER, $PAGER or less -FRX)")
+	rootCmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return fmt.Errorf("%w\nrun '%s --help' for usage", err, cmd.CommandPath())
+	})
 }
 
 // Execute adds all child commands to the root command sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
+	err := rootCmd.Execute()
+	defaultIOStreams.StopPager()
+	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
 }
+
+// noPagerAnnotation returns the command annotations which disables the pager.
+func noPagerAnnotation() map[string]string {
+	return map[string]string{annotationNoPager: "true"}
+}
@@ -65,17 +65,17 @@ func runStarList(cmd *cobra.Command, args []string) error {
 		starUsername = args[0]
 	}
 
-	resultc, errc := listStarred(ctx, starUsername)
+	progress := spin.NewProgress(os.Stderr)
+	task := progress.AddTask("fetching", 0)
+	progress.Start()
+
+	resultc, errc := listStarred(ctx, starUsername, progress, task)
 
-	s := spin.NewSpin()
 	var results []starListResult
-	i := 1
 	for res := range resultc {
-		s.Next("fetching", fmt.Sprintf("page: %d/%d", i, cap(resultc)))
 		results = append(results, appendStarResult(res)...)
-		i++
 	}
-	s.Flush()
+	progress.Stop()
 
 	// handle first error
 	if err := <-errc; err != nil {
@@ -87,9 +87,9 @@ func runStarList(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return fmt.Errorf("could not marshal to JSON: %w", err)
 		}
-		fmt.Print(string(buf))
+		fmt.Fprint(defaultIOStreams.Out, string(buf))
 	} else {
-		w := tabwriter.NewWriter(os.Stdout, 0, 8, 0, '\t', tabwriter.AlignRight)
+		w := tabwriter.NewWriter(defaultIOStreams.Out, 0, 8, 0, '\t', tabwriter.AlignRight)
 		for _, res := range results {
 			fmt.Fprintf(w, "owner: %s\turl: %s\n", res.OwnerName, res.URL)
 		}
@@ -101,7 +101,9 @@ func runStarList(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func listStarred(ctx context.Context, username string) (<-chan []*github.StarredRepository, <-chan error) {
+// listStarred lists the starred repositories of username concurrently.
+// The fetched pages are reported to task, and the API rate limit to progress.
+func listStarred(ctx context.Context, username string, progress *spin.Progress, task *spin.Task) (<-chan []*github.StarredRepository, <-chan error) {
 	client := newClient(ctx)
 	options := &github.ActivityListStarredOptions{Sort: starListSort}
 	options.Page = 1
@@ -120,6 +122,12 @@ func listStarred(ctx context.Context, username string) (<-chan []*github.Starred
 	}
 
 	lastPage := firstRes.LastPage
+	if lastPage == 0 {
+		lastPage = 1 // only one page
+	}
+	task.SetTotal(lastPage)
+	task.Increment()
+	progress.SetRateLimit(firstRes.Rate.Remaining, firstRes.Rate.Limit)
 
 	resultsc := make(chan []*github.StarredRepository, lastPage)
 	resultsc <- firstRepos
@@ -140,7 +148,7 @@ func listStarred(ctx context.Context, username string) (<-chan []*github.Starred
 
 				opts := *options // copy
 				opts.Page = i + 1
-				repos, _, err := client.Activity.ListStarred(ctx, username, &opts)
+				repos, resp, err := client.Activity.ListStarred(ctx, username, &opts)
 				if err != nil {
 					err = checkRateLimitError(err)
 					errs = append(errs, fmt.Errorf("could not get list starred: %w", err))
@@ -148,6 +156,8 @@ func listStarred(ctx context.Context, username string) (<-chan []*github.Starred
 				}
 
 				resultsc <- repos
+				task.Increment()
+				progress.SetRateLimit(resp.Rate.Remaining, resp.Rate.Limit)
 			}(i)
 		}
 
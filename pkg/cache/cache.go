@@ -0,0 +1,112 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cache implements the short-lived local file cache of the GitHub API results.
+package cache
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Cache represents the file cache which entries are expired after TTL.
+type Cache struct {
+	dir string
+	ttl time.Duration
+}
+
+// Dir returns the default cache directory.
+//
+// It is the $GHCTL_CACHE_DIR if set, otherwise ghctl under the os.UserCacheDir.
+func Dir() (string, error) {
+	if dir := os.Getenv("GHCTL_CACHE_DIR"); dir != "" {
+		return dir, nil
+	}
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("cache: could not get user cache directory: %w", err)
+	}
+	return filepath.Join(dir, "ghctl"), nil
+}
+
+// New returns the new Cache which stores entries to dir.
+func New(dir string, ttl time.Duration) *Cache {
+	return &Cache{
+		dir: dir,
+		ttl: ttl,
+	}
+}
+
+// Get reads the cached entry of key into v and reports whether the fresh entry was found.
+func (c *Cache) Get(key string, v interface{}) (bool, error) {
+	fname := c.path(key)
+	fi, err := os.Stat(fname)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		return false, fmt.Errorf("cache: could not stat %s: %w", fname, err)
+	}
+	if time.Since(fi.ModTime()) > c.ttl {
+		return false, nil
+	}
+
+	data, err := os.ReadFile(fname)
+	if err != nil {
+		return false, fmt.Errorf("cache: could not read %s: %w", fname, err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		// treat the broken entry as the cache miss
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// Set stores v as the entry of key.
+func (c *Cache) Set(key string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("cache: could not marshal %s entry: %w", key, err)
+	}
+	if err := os.MkdirAll(c.dir, 0o700); err != nil {
+		return fmt.Errorf("cache: could not create %s directory: %w", c.dir, err)
+	}
+
+	// write to the temporary file and rename it for the atomic update
+	tmp, err := os.CreateTemp(c.dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("cache: could not create temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("cache: could not write %s entry: %w", key, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("cache: could not close temporary file: %w", err)
+	}
+
+	return os.Rename(tmp.Name(), c.path(key))
+}
+
+// Delete removes the entry of key.
+func (c *Cache) Delete(key string) error {
+	if err := os.Remove(c.path(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("cache: could not delete %s entry: %w", key, err)
+	}
+	return nil
+}
+
+func (c *Cache) path(key string) string {
+	sum := sha1.Sum([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package codeowners parses the GitHub CODEOWNERS file and matches the file paths to its owners.
+package codeowners
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// Paths is the candidate paths of the CODEOWNERS file in the repository, in the order GitHub looks up.
+var Paths = []string{
+	".github/CODEOWNERS",
+	"CODEOWNERS",
+	"docs/CODEOWNERS",
+}
+
+// Rule represents the line of the CODEOWNERS file.
+type Rule struct {
+	Pattern string
+	Owners  []string
+
+	re *regexp.Regexp
+}
+
+// Match reports whether the path matches the pattern of r.
+func (r *Rule) Match(path string) bool {
+	return r.re.MatchString(strings.TrimPrefix(path, "/"))
+}
+
+// File represents the parsed CODEOWNERS file.
+type File struct {
+	Rules []*Rule
+}
+
+// Parse parses the CODEOWNERS file from r.
+func Parse(r io.Reader) (*File, error) {
+	f := &File{}
+
+	sc := bufio.NewScanner(r)
+	lineno := 0
+	for sc.Scan() {
+		lineno++
+		line := sc.Text()
+		if i := strings.Index(line, "#"); i >= 0 && (i == 0 || line[i-1] != '\\') {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		re, err := compilePattern(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("codeowners: line %d: %w", lineno, err)
+		}
+		f.Rules = append(f.Rules, &Rule{
+			Pattern: fields[0],
+			Owners:  fields[1:],
+			re:      re,
+		})
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("codeowners: %w", err)
+	}
+
+	return f, nil
+}
+
+// Owners returns the owners of path. The last matching rule takes precedence, as GitHub does.
+// It returns nil if no rule matches, or the matched rule has no owners.
+func (f *File) Owners(path string) []string {
+	for i := len(f.Rules) - 1; i >= 0; i-- {
+		if f.Rules[i].Match(path) {
+			return f.Rules[i].Owners
+		}
+	}
+	return nil
+}
+
+// compilePattern compiles the gitignore style pattern of CODEOWNERS to the regexp.
+//
+// The pattern which has "/" at the beginning or middle is anchored to the repository root, otherwise matches at any directory.
+// "*" matches any characters except "/", and "**" matches any characters including "/".
+// The pattern also matches the all files under the directory it matches, except the pattern which ends with "/*".
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	p := strings.TrimSuffix(pattern, "/")
+	anchored := strings.Contains(p, "/")
+	p = strings.TrimPrefix(p, "/")
+
+	var sb strings.Builder
+	sb.WriteString("^")
+	if !anchored {
+		sb.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(p); i++ {
+		switch ch := p[i]; ch {
+		case '*':
+			if i+1 < len(p) && p[i+1] == '*' {
+				i++
+				if i+1 < len(p) && p[i+1] == '/' {
+					// "**/" matches zero or more directories
+					i++
+					sb.WriteString("(?:.*/)?")
+				} else {
+					sb.WriteString(".*")
+				}
+				continue
+			}
+			sb.WriteString("[^/]*")
+		case '?':
+			sb.WriteString("[^/]")
+		case '\\':
+			if i+1 < len(p) {
+				i++
+				sb.WriteString(regexp.QuoteMeta(string(p[i])))
+			}
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(ch)))
+		}
+	}
+	// the pattern which ends with the single "*" segment, such as "docs/*", matches the direct children only,
+	// otherwise the pattern can name the directory and also matches its descendants
+	if last := p[strings.LastIndexByte(p, '/')+1:]; last != "*" || strings.HasSuffix(pattern, "/") {
+		sb.WriteString("(?:/.*)?")
+	}
+	sb.WriteString("$")
+
+	return regexp.Compile(sb.String())
+}
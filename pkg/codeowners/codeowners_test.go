@@ -0,0 +1,73 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package codeowners
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRuleMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{pattern: "docs/*", path: "docs/getting-started.md", want: true},
+		{pattern: "docs/*", path: "docs/build-app/troubleshooting.md", want: false},
+		{pattern: "docs/*", path: "src/docs/a.md", want: false},
+		{pattern: "/docs/", path: "docs/a.md", want: true},
+		{pattern: "/docs/", path: "docs/a/b.md", want: true},
+		{pattern: "/docs/", path: "src/docs/a.md", want: false},
+		{pattern: "**/logs", path: "logs/a.log", want: true},
+		{pattern: "**/logs", path: "build/logs/a.log", want: true},
+		{pattern: "**/logs", path: "deeply/nested/logs/a/b.log", want: true},
+		{pattern: "**/logs", path: "build/logsfile", want: false},
+		{pattern: "*.js", path: "index.js", want: true},
+		{pattern: "*.js", path: "src/app/index.js", want: true},
+		{pattern: "*.js", path: "index.jsx", want: false},
+		{pattern: "*", path: "a/b/c.go", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.path, func(t *testing.T) {
+			f, err := Parse(strings.NewReader(tt.pattern + " @owner\n"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := f.Rules[0].Match(tt.path); got != tt.want {
+				t.Errorf("Match(%q) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFileOwners(t *testing.T) {
+	f, err := Parse(strings.NewReader(`# comment
+*       @org/all
+*.js    @js-owner
+docs/*  docs@example.com
+/apps/  @apps-owner   # trailing comment
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "README.md", want: "@org/all"},
+		{path: "src/index.js", want: "@js-owner"},
+		{path: "docs/index.md", want: "docs@example.com"},
+		{path: "docs/nested/index.md", want: "@org/all"},
+		{path: "apps/web/main.js", want: "@apps-owner"},
+	}
+	for _, tt := range tests {
+		got := strings.Join(f.Owners(tt.path), " ")
+		if got != tt.want {
+			t.Errorf("Owners(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package config provides the ghctl configuration directory and files.
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Dir returns the ghctl configuration directory.
+//
+// It is the $GHCTL_CONFIG_DIR if set, otherwise ghctl under the os.UserConfigDir.
+func Dir() (string, error) {
+	if dir := os.Getenv("GHCTL_CONFIG_DIR"); dir != "" {
+		return dir, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("config: could not get user config directory: %w", err)
+	}
+	return filepath.Join(dir, "ghctl"), nil
+}
+
+// Path returns the path of the name file in the configuration directory.
+func Path(name string) (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name), nil
+}
+
+// FileName is the name of the configuration file in the configuration directory.
+const FileName = "config.json"
+
+// Config represents the ghctl configuration file.
+type Config struct {
+	// Changelog is the configuration of the changelog command.
+	Changelog Changelog `json:"changelog"`
+	// Status is the configuration of the pr status command.
+	Status Status `json:"status"`
+	// Stale is the configuration of the pr stale command.
+	Stale Stale `json:"stale"`
+	// Reviewers is the configuration of the pr assign-reviewers command.
+	Reviewers Reviewers `json:"reviewers"`
+}
+
+// Status represents the configuration of the pr status command.
+type Status struct {
+	// Repositories is the list of owner or owner/repo names which the pr status command searches.
+	// The empty list means the all repositories.
+	Repositories []string `json:"repositories"`
+}
+
+// ChangelogSection represents the section of the changelog.
+type ChangelogSection struct {
+	// Title is the section title.
+	Title string `json:"title"`
+	// Labels is the pull request labels which belong to the section.
+	Labels []string `json:"labels"`
+}
+
+// Changelog represents the configuration of the changelog command.
+type Changelog struct {
+	// Sections is the list of sections. The pull request belongs to the first section which has any of its labels.
+	Sections []ChangelogSection `json:"sections"`
+	// OtherTitle is the section title of the pull requests which belong to no section.
+	OtherTitle string `json:"other_title"`
+	// ExcludeLabels is the pull request labels to exclude from the changelog.
+	ExcludeLabels []string `json:"exclude_labels"`
+}
+
+// DefaultChangelog is the changelog configuration used if the configuration file has no sections.
+var DefaultChangelog = Changelog{
+	Sections: []ChangelogSection{
+		{Title: "Breaking Changes", Labels: []string{"breaking-change", "breaking"}},
+		{Title: "Features", Labels: []string{"feature", "enhancement"}},
+		{Title: "Bug Fixes", Labels: []string{"bug", "fix"}},
+		{Title: "Documentation", Labels: []string{"documentation", "docs"}},
+		{Title: "Dependencies", Labels: []string{"dependencies"}},
+	},
+	OtherTitle:    "Other Changes",
+	ExcludeLabels: []string{"skip-changelog"},
+}
+
+// Stale represents the configuration of the pr stale command.
+type Stale struct {
+	// Days is the number of inactive days which the pull request is stale.
+	Days int `json:"days"`
+	// CloseDays is the number of inactive days which the stale pull request is closed. Zero disables closing.
+	CloseDays int `json:"close_days"`
+	// Label is the label added to the stale pull requests.
+	Label string `json:"label"`
+	// Comment is the text/template of the reminder comment on the stale pull requests.
+	Comment string `json:"comment"`
+	// CloseComment is the text/template of the comment on the closed pull requests.
+	CloseComment string `json:"close_comment"`
+}
+
+// DefaultStale is the pr stale command configuration used for the zero fields of the configuration file.
+var DefaultStale = Stale{
+	Days:  30,
+	Label: "stale",
+	Comment: "@{{.Author}} this pull request has had no activity for {{.Days}} days. " +
+		"{{if .CloseDays}}It will be closed after {{.CloseDays}} days of inactivity. {{end}}" +
+		"Please update it or let us know if it is still in progress.",
+	CloseComment: "@{{.Author}} closing this pull request since it has had no activity for {{.Days}} days. Feel free to reopen it.",
+}
+
+// Reviewers represents the configuration of the pr assign-reviewers command.
+type Reviewers struct {
+	// Team is the org/team which members are assigned round-robin if no code owner is found.
+	Team string `json:"team"`
+	// Teams is the org/team of each owner/repo repository, which takes precedence over Team.
+	Teams map[string]string `json:"teams"`
+	// Count is the number of reviewers assigned from the team. Zero means one.
+	Count int `json:"count"`
+}
+
+// TeamOf returns the fallback team of the owner/repo repository.
+func (r Reviewers) TeamOf(fullname string) string {
+	for name, team := range r.Teams {
+		if strings.EqualFold(name, fullname) {
+			return team
+		}
+	}
+	return r.Team
+}
+
+// Load loads the configuration from the fname file.
+// If fname is empty, loads the FileName file in the configuration directory.
+// It is not an error if the file does not exist, and returns the zero Config.
+func Load(fname string) (*Config, error) {
+	if fname == "" {
+		var err error
+		if fname, err = Path(FileName); err != nil {
+			return nil, err
+		}
+	}
+
+	cfg := &Config{}
+	data, err := os.ReadFile(fname)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return cfg, nil
+		}
+		return nil, fmt.Errorf("config: could not read %s: %w", fname, err)
+	}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("config: could not parse %s: %w", fname, err)
+	}
+
+	return cfg, nil
+}
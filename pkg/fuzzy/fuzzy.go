@@ -0,0 +1,117 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package fuzzy implements the fuzzy string matching used by the interactive finder.
+package fuzzy
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	scoreMatch       = 16
+	bonusBoundary    = 8
+	bonusConsecutive = 4
+	bonusFirstChar   = 8
+	penaltyGap       = 1
+)
+
+// Match represents the result of a matched string.
+type Match struct {
+	// Str is the matched string.
+	Str string
+	// Index is the index of Str in the original slice.
+	Index int
+	// Score is the score of the match. Higher is better.
+	Score int
+	// Positions is the byte offsets of the matched characters in Str.
+	Positions []int
+}
+
+// MatchString reports whether the all characters of pattern appear in s in order, ignoring case,
+// and returns the score and matched positions.
+func MatchString(pattern, s string) (score int, positions []int, ok bool) {
+	if pattern == "" {
+		return 0, nil, true
+	}
+
+	pattern = strings.ToLower(pattern)
+	pr, psize := utf8.DecodeRuneInString(pattern)
+
+	prev := rune(0)
+	consecutive := false
+	lastMatch := -1
+	for i, r := range s {
+		if pr == utf8.RuneError && psize == 0 {
+			break
+		}
+
+		if unicode.ToLower(r) == pr {
+			score += scoreMatch
+			switch {
+			case i == 0:
+				score += bonusFirstChar
+			case isBoundary(prev, r):
+				score += bonusBoundary
+			}
+			if consecutive {
+				score += bonusConsecutive
+			}
+			if lastMatch >= 0 {
+				score -= (i - lastMatch - 1) * penaltyGap
+			}
+			positions = append(positions, i)
+			lastMatch = i
+			consecutive = true
+
+			pattern = pattern[psize:]
+			pr, psize = utf8.DecodeRuneInString(pattern)
+		} else {
+			consecutive = false
+		}
+		prev = r
+	}
+
+	if pattern != "" {
+		return 0, nil, false
+	}
+
+	return score, positions, true
+}
+
+// isBoundary reports whether r is the start of a word after prev.
+func isBoundary(prev, r rune) bool {
+	switch prev {
+	case '/', '-', '_', '.', ' ':
+		return true
+	}
+	return unicode.IsLower(prev) && unicode.IsUpper(r)
+}
+
+// Find returns the matches of pattern in data sorted by score.
+// The original order of data is preserved for the same score.
+func Find(pattern string, data []string) []Match {
+	matches := make([]Match, 0, len(data))
+	for i, s := range data {
+		score, positions, ok := MatchString(pattern, s)
+		if !ok {
+			continue
+		}
+		matches = append(matches, Match{
+			Str:       s,
+			Index:     i,
+			Score:     score,
+			Positions: positions,
+		})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+
+	return matches
+}
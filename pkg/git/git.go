@@ -0,0 +1,281 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package git provides the operations of the local git repository by running the git command.
+package git
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"sort"
+	"strings"
+)
+
+// ErrNotOnBranch is returned if HEAD is detached.
+var ErrNotOnBranch = errors.New("git: not on any branch")
+
+// Run runs the git command with args in the current directory and returns the trimmed stdout.
+func Run(ctx context.Context, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			return "", fmt.Errorf("git %s: %w", strings.Join(args, " "), err)
+		}
+		return "", fmt.Errorf("git %s: %s: %w", strings.Join(args, " "), msg, err)
+	}
+	return strings.TrimRight(stdout.String(), "\n"), nil
+}
+
+// TopLevel returns the absolute path of the top-level directory of the working tree.
+func TopLevel(ctx context.Context) (string, error) {
+	return Run(ctx, "rev-parse", "--show-toplevel")
+}
+
+// CurrentBranch returns the current branch name.
+func CurrentBranch(ctx context.Context) (string, error) {
+	branch, err := Run(ctx, "symbolic-ref", "--quiet", "--short", "HEAD")
+	if err != nil {
+		return "", ErrNotOnBranch
+	}
+	return branch, nil
+}
+
+// Config returns the git config value of key. It returns the empty string if key is not set.
+func Config(ctx context.Context, key string) string {
+	value, err := Run(ctx, "config", "--get", key)
+	if err != nil {
+		return ""
+	}
+	return value
+}
+
+// RevParse returns the commit SHA of rev.
+func RevParse(ctx context.Context, rev string) (string, error) {
+	return Run(ctx, "rev-parse", "--verify", "--quiet", rev)
+}
+
+// HasRef reports whether the ref exists.
+func HasRef(ctx context.Context, ref string) bool {
+	_, err := Run(ctx, "show-ref", "--verify", "--quiet", ref)
+	return err == nil
+}
+
+// Remote represents the git remote of the GitHub repository.
+type Remote struct {
+	// Name is the remote name, such as origin.
+	Name string
+	// URL is the fetch URL of the remote.
+	URL string
+	// Owner is the GitHub repository owner.
+	Owner string
+	// Repo is the GitHub repository name.
+	Repo string
+}
+
+// FullName returns the owner/repo name of r.
+func (r *Remote) FullName() string {
+	return r.Owner + "/" + r.Repo
+}
+
+// Remotes returns the remotes which point to the github.com repository.
+// The remotes are sorted as upstream, github, origin and others by name.
+func Remotes(ctx context.Context) ([]*Remote, error) {
+	out, err := Run(ctx, "remote", "-v")
+	if err != nil {
+		return nil, err
+	}
+
+	var remotes []*Remote
+	seen := make(map[string]bool)
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 3 || fields[2] != "(fetch)" || seen[fields[0]] {
+			continue
+		}
+		owner, repo, ok := ParseRepositoryURL(fields[1])
+		if !ok {
+			continue
+		}
+		seen[fields[0]] = true
+		remotes = append(remotes, &Remote{
+			Name:  fields[0],
+			URL:   fields[1],
+			Owner: owner,
+			Repo:  repo,
+		})
+	}
+
+	priority := func(name string) int {
+		switch name {
+		case "upstream":
+			return 0
+		case "github":
+			return 1
+		case "origin":
+			return 2
+		}
+		return 3
+	}
+	sort.SliceStable(remotes, func(i, j int) bool {
+		pi, pj := priority(remotes[i].Name), priority(remotes[j].Name)
+		if pi != pj {
+			return pi < pj
+		}
+		return remotes[i].Name < remotes[j].Name
+	})
+
+	return remotes, nil
+}
+
+// FindRemote returns the remote named name from remotes, or nil if not found.
+func FindRemote(remotes []*Remote, name string) *Remote {
+	for _, remote := range remotes {
+		if remote.Name == name {
+			return remote
+		}
+	}
+	return nil
+}
+
+// ParseRepositoryURL parses the github.com repository URL of the https, ssh or scp-like syntax,
+// and returns the owner and repository name.
+func ParseRepositoryURL(rawurl string) (owner, repo string, ok bool) {
+	var path string
+	switch {
+	case strings.Contains(rawurl, "://"):
+		u, err := url.Parse(rawurl)
+		if err != nil || !isGitHubHost(u.Hostname()) {
+			return "", "", false
+		}
+		path = u.Path
+	case strings.Contains(rawurl, ":"):
+		// scp-like syntax, such as git@github.com:owner/repo.git
+		i := strings.IndexByte(rawurl, ':')
+		host := rawurl[:i]
+		if at := strings.IndexByte(host, '@'); at >= 0 {
+			host = host[at+1:]
+		}
+		if !isGitHubHost(host) {
+			return "", "", false
+		}
+		path = rawurl[i+1:]
+	default:
+		return "", "", false
+	}
+
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	ss := strings.Split(path, "/")
+	if len(ss) != 2 || ss[0] == "" || ss[1] == "" {
+		return "", "", false
+	}
+
+	return ss[0], ss[1], true
+}
+
+func isGitHubHost(host string) bool {
+	host = strings.ToLower(host)
+	return host == "github.com" || host == "www.github.com" || host == "ssh.github.com"
+}
+
+// Commit represents the git commit.
+type Commit struct {
+	SHA     string
+	Subject string
+	Body    string
+}
+
+// Commits returns the commits reachable from head but not from base, in the oldest first order.
+func Commits(ctx context.Context, base, head string) ([]Commit, error) {
+	const (
+		fieldSep  = "\x00"
+		recordSep = "\x1e"
+	)
+	out, err := Run(ctx, "log", "--reverse", "--format=%H%x00%s%x00%b%x1e", base+".."+head)
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []Commit
+	for _, record := range strings.Split(out, recordSep) {
+		record = strings.TrimLeft(record, "\n")
+		if record == "" {
+			continue
+		}
+		fields := strings.SplitN(record, fieldSep, 3)
+		if len(fields) != 3 {
+			continue
+		}
+		commits = append(commits, Commit{
+			SHA:     fields[0],
+			Subject: fields[1],
+			Body:    strings.TrimSpace(fields[2]),
+		})
+	}
+
+	return commits, nil
+}
+
+// IsSSH reports whether the remote URL uses the ssh protocol.
+func (r *Remote) IsSSH() bool {
+	return strings.HasPrefix(r.URL, "ssh://") || !strings.Contains(r.URL, "://")
+}
+
+// Push pushes the local branch to the remote branch of the remote, and sets it as the upstream.
+func Push(ctx context.Context, remote, branch string) error {
+	_, err := Run(ctx, "push", "--set-upstream", remote, "HEAD:refs/heads/"+branch)
+	return err
+}
+
+// Fetch fetches the refspecs from the remote.
+func Fetch(ctx context.Context, remote string, refspecs ...string) error {
+	_, err := Run(ctx, append([]string{"fetch", remote}, refspecs...)...)
+	return err
+}
+
+// SetConfig sets the git config value of key in the repository.
+func SetConfig(ctx context.Context, key, value string) error {
+	_, err := Run(ctx, "config", key, value)
+	return err
+}
+
+// Checkout runs the git checkout command with args.
+func Checkout(ctx context.Context, args ...string) error {
+	_, err := Run(ctx, append([]string{"checkout"}, args...)...)
+	return err
+}
+
+// MergeFastForward fast-forwards the current branch to rev.
+func MergeFastForward(ctx context.Context, rev string) error {
+	_, err := Run(ctx, "merge", "--ff-only", rev)
+	return err
+}
+
+// IsAncestor reports whether the commit of ancestor is the ancestor of the commit of rev.
+func IsAncestor(ctx context.Context, ancestor, rev string) bool {
+	_, err := Run(ctx, "merge-base", "--is-ancestor", ancestor, rev)
+	return err == nil
+}
+
+// IsClean reports whether the working tree and the index have no changes of the tracked files.
+func IsClean(ctx context.Context) (bool, error) {
+	out, err := Run(ctx, "status", "--porcelain", "--untracked-files=no")
+	if err != nil {
+		return false, err
+	}
+	return out == "", nil
+}
+
+// ResetHard resets the current branch, the index and the working tree to rev.
+func ResetHard(ctx context.Context, rev string) error {
+	_, err := Run(ctx, "reset", "--hard", rev)
+	return err
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package ignore implements the ignore rules of the repository owners and owner/repo names.
+//
+// The rule is the glob pattern, or the regular expression with the "re:" prefix.
+// The glob pattern without "/" matches the owner, and with "/" matches the owner/repo name.
+// The regular expression always matches the owner/repo name, so use such as "re:^sandbox-.*/" to match the owners.
+// All rules match case-insensitively as same as GitHub.
+package ignore
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// regexpPrefix is the prefix of the regular expression rule.
+const regexpPrefix = "re:"
+
+type rule struct {
+	glob string
+	re   *regexp.Regexp
+	repo bool
+}
+
+func (r *rule) match(owner, repo string) bool {
+	fullname := strings.ToLower(owner + "/" + repo)
+	if r.re != nil {
+		return r.re.MatchString(fullname)
+	}
+
+	name := strings.ToLower(owner)
+	if r.repo {
+		name = fullname
+	}
+	ok, _ := path.Match(r.glob, name) // the pattern is already validated by Add
+	return ok
+}
+
+// Rules represents the list of ignore rules.
+type Rules struct {
+	rules []*rule
+}
+
+// New returns the new Rules from patterns.
+func New(patterns ...string) (*Rules, error) {
+	r := &Rules{}
+	for _, pattern := range patterns {
+		if err := r.Add(pattern); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Add adds the pattern rule to r.
+func (r *Rules) Add(pattern string) error {
+	pattern = strings.TrimSpace(pattern)
+	if pattern == "" {
+		return errors.New("ignore: empty pattern")
+	}
+
+	if strings.HasPrefix(pattern, regexpPrefix) {
+		re, err := regexp.Compile("(?i)" + strings.TrimPrefix(pattern, regexpPrefix))
+		if err != nil {
+			return fmt.Errorf("ignore: invalid regular expression %q: %w", pattern, err)
+		}
+		r.rules = append(r.rules, &rule{re: re, repo: true})
+		return nil
+	}
+
+	glob := strings.ToLower(pattern)
+	if _, err := path.Match(glob, ""); err != nil {
+		return fmt.Errorf("ignore: invalid glob pattern %q: %w", pattern, err)
+	}
+	r.rules = append(r.rules, &rule{glob: glob, repo: strings.Contains(glob, "/")})
+
+	return nil
+}
+
+// Read reads the rules from rd and adds them to r.
+// Each line is the one rule, and the empty lines and lines starting with "#" are ignored.
+func (r *Rules) Read(rd io.Reader) error {
+	sc := bufio.NewScanner(rd)
+	for lineno := 1; sc.Scan(); lineno++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if err := r.Add(line); err != nil {
+			return fmt.Errorf("line %d: %w", lineno, err)
+		}
+	}
+	return sc.Err()
+}
+
+// Load reads the rules from the fname file and adds them to r.
+// It is not an error if the fname file does not exist.
+func (r *Rules) Load(fname string) error {
+	f, err := os.Open(fname)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("ignore: could not open %s: %w", fname, err)
+	}
+	defer f.Close()
+
+	if err := r.Read(f); err != nil {
+		return fmt.Errorf("ignore: %s: %w", fname, err)
+	}
+
+	return nil
+}
+
+// Match reports whether the owner/repo matches any of rules.
+func (r *Rules) Match(owner, repo string) bool {
+	if r == nil {
+		return false
+	}
+	for _, rule := range r.rules {
+		if rule.match(owner, repo) {
+			return true
+		}
+	}
+	return false
+}
+
+// Len returns the number of rules.
+func (r *Rules) Len() int {
+	if r == nil {
+		return 0
+	}
+	return len(r.rules)
+}
@@ -0,0 +1,277 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package picker implements the interactive fuzzy finder.
+package picker
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	color "github.com/zchee/color/v2"
+
+	"github.com/zchee/ghctl/pkg/fuzzy"
+	"github.com/zchee/ghctl/pkg/term"
+)
+
+// ErrCancelled is returned if the user cancelled the picker.
+var ErrCancelled = errors.New("picker: cancelled")
+
+// defaultHeight is the maximum number of candidate lines if the terminal size is unknown.
+const defaultHeight = 15
+
+// Picker represents the interactive fuzzy finder.
+type Picker struct {
+	// In is the terminal to read the key input. It must be a terminal.
+	In *os.File
+	// Out is where the finder is rendered, usually os.Stderr.
+	Out io.Writer
+	// Prompt is the prompt string shown in front of the query.
+	Prompt string
+	// Multi enables selecting multiple items with the Tab key.
+	Multi bool
+	// Height is the maximum number of candidate lines. If zero, it is computed from the terminal size.
+	Height int
+
+	items    []string
+	query    []rune
+	matches  []fuzzy.Match
+	cursor   int
+	offset   int
+	selected map[int]bool
+	lines    int
+}
+
+// New returns the new Picker which reads from in and renders to out.
+func New(in *os.File, out io.Writer, prompt string, multi bool) *Picker {
+	return &Picker{
+		In:     in,
+		Out:    out,
+		Prompt: prompt,
+		Multi:  multi,
+	}
+}
+
+// Pick runs the finder over items and returns the chosen items.
+//
+// If Multi is false, the returned slice has exactly one item.
+func (p *Picker) Pick(items []string) ([]string, error) {
+	if len(items) == 0 {
+		return nil, errors.New("picker: no items to pick")
+	}
+	if !term.IsTerminal(p.In.Fd()) {
+		return nil, errors.New("picker: input is not a terminal")
+	}
+
+	p.items = items
+	p.query = nil
+	p.selected = make(map[int]bool)
+	p.cursor, p.offset, p.lines = 0, 0, 0
+	if p.Height == 0 {
+		p.Height = defaultHeight
+		if _, h, err := term.GetSize(p.In.Fd()); err == nil && h > 2 {
+			if h-1 < p.Height {
+				p.Height = h - 1
+			}
+		}
+	}
+
+	oldState, err := term.MakeRaw(p.In.Fd())
+	if err != nil {
+		return nil, fmt.Errorf("picker: could not make raw terminal: %w", err)
+	}
+	defer term.Restore(p.In.Fd(), oldState) //nolint:errcheck
+
+	p.filter()
+	p.render()
+	defer p.clear()
+
+	buf := make([]byte, 64)
+	for {
+		n, err := p.In.Read(buf)
+		if err != nil {
+			return nil, fmt.Errorf("picker: could not read input: %w", err)
+		}
+
+		done, err := p.handle(buf[:n])
+		if err != nil {
+			return nil, err
+		}
+		if done {
+			return p.result(), nil
+		}
+		p.render()
+	}
+}
+
+// handle handles the input key and reports whether the picking is done.
+func (p *Picker) handle(key []byte) (bool, error) {
+	switch s := string(key); s {
+	case "\x03", "\x1b": // Ctrl-C, Esc
+		return false, ErrCancelled
+	case "\r", "\n":
+		if len(p.matches) == 0 && len(p.selected) == 0 {
+			return false, nil
+		}
+		return true, nil
+	case "\x1b[A", "\x1bOA", "\x10": // Up, Ctrl-P
+		p.move(-1)
+	case "\x1b[B", "\x1bOB", "\x0e": // Down, Ctrl-N
+		p.move(1)
+	case "\t":
+		if p.Multi && len(p.matches) > 0 {
+			idx := p.matches[p.cursor].Index
+			if p.selected[idx] {
+				delete(p.selected, idx)
+			} else {
+				p.selected[idx] = true
+			}
+			p.move(1)
+		}
+	case "\x7f", "\x08": // Backspace, Ctrl-H
+		if len(p.query) > 0 {
+			p.query = p.query[:len(p.query)-1]
+			p.filter()
+		}
+	case "\x15": // Ctrl-U
+		p.query = nil
+		p.filter()
+	default:
+		if strings.HasPrefix(s, "\x1b") {
+			return false, nil // ignore unknown escape sequence
+		}
+		changed := false
+		for len(s) > 0 {
+			r, size := utf8.DecodeRuneInString(s)
+			s = s[size:]
+			if r == utf8.RuneError || !unicode.IsPrint(r) {
+				continue
+			}
+			p.query = append(p.query, r)
+			changed = true
+		}
+		if changed {
+			p.filter()
+		}
+	}
+
+	return false, nil
+}
+
+func (p *Picker) filter() {
+	p.matches = fuzzy.Find(string(p.query), p.items)
+	p.cursor, p.offset = 0, 0
+}
+
+func (p *Picker) move(delta int) {
+	if len(p.matches) == 0 {
+		return
+	}
+	p.cursor += delta
+	if p.cursor < 0 {
+		p.cursor = 0
+	}
+	if p.cursor >= len(p.matches) {
+		p.cursor = len(p.matches) - 1
+	}
+	if p.cursor < p.offset {
+		p.offset = p.cursor
+	}
+	if p.cursor >= p.offset+p.Height {
+		p.offset = p.cursor - p.Height + 1
+	}
+}
+
+func (p *Picker) result() []string {
+	if p.Multi && len(p.selected) > 0 {
+		res := make([]string, 0, len(p.selected))
+		for i, item := range p.items {
+			if p.selected[i] {
+				res = append(res, item)
+			}
+		}
+		return res
+	}
+	return []string{p.matches[p.cursor].Str}
+}
+
+// clear erases the lines drawn by the last render.
+func (p *Picker) clear() {
+	if p.lines == 0 {
+		return
+	}
+	fmt.Fprint(p.Out, "\r\x1b[J")
+	p.lines = 0
+}
+
+// render redraws the query line and candidates.
+//
+// The terminal is in raw mode, so the line feed requires the explicit carriage return.
+func (p *Picker) render() {
+	var sb strings.Builder
+	sb.WriteString("\r\x1b[J")
+
+	status := fmt.Sprintf("%d/%d", len(p.matches), len(p.items))
+	if p.Multi {
+		status += fmt.Sprintf(" (%d selected)", len(p.selected))
+	}
+	fmt.Fprintf(&sb, "%s%s  %s", color.BlueString(p.Prompt), string(p.query), color.New(color.Faint).Sprint(status))
+
+	end := p.offset + p.Height
+	if end > len(p.matches) {
+		end = len(p.matches)
+	}
+	for i := p.offset; i < end; i++ {
+		m := p.matches[i]
+		sb.WriteString("\r\n")
+		mark := "  "
+		if p.selected[m.Index] {
+			mark = color.GreenString("* ")
+		}
+		if i == p.cursor {
+			sb.WriteString(color.RedString(">"))
+		} else {
+			sb.WriteString(" ")
+		}
+		sb.WriteString(mark)
+		sb.WriteString(highlight(m))
+	}
+
+	// move the cursor back to the end of query line
+	if lines := end - p.offset; lines > 0 {
+		fmt.Fprintf(&sb, "\x1b[%dA", lines)
+	}
+	sb.WriteString("\r")
+	if col := utf8.RuneCountInString(p.Prompt) + len(p.query); col > 0 {
+		fmt.Fprintf(&sb, "\x1b[%dC", col)
+	}
+
+	fmt.Fprint(p.Out, sb.String())
+	p.lines = end - p.offset + 1
+}
+
+// highlight returns the m.Str with the matched characters highlighted.
+func highlight(m fuzzy.Match) string {
+	if len(m.Positions) == 0 {
+		return m.Str
+	}
+
+	var sb strings.Builder
+	pos := 0
+	for i, r := range m.Str {
+		if pos < len(m.Positions) && m.Positions[pos] == i {
+			sb.WriteString(color.YellowString(string(r)))
+			pos++
+			continue
+		}
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
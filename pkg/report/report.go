@@ -0,0 +1,100 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package report renders the self-contained HTML report.
+package report
+
+import (
+	_ "embed" // for go:embed
+	"fmt"
+	"html/template"
+	"io"
+	"time"
+)
+
+var (
+	//go:embed report.css
+	reportCSS string
+
+	//go:embed report.js
+	reportJS string
+
+	//go:embed report.html.tmpl
+	reportTmpl string
+)
+
+var tmpl = template.Must(template.New("report").Funcs(template.FuncMap{
+	"css": func() template.CSS { return template.CSS(reportCSS) },
+	"js":  func() template.JS { return template.JS(reportJS) },
+}).Parse(reportTmpl))
+
+// Cell represents the table cell.
+type Cell struct {
+	// Text is the displayed text.
+	Text string
+	// Link is the URL of the Text. It is rendered as the plain text if empty.
+	Link string
+	// SortKey is the key used by sorting the column instead of Text if not empty.
+	SortKey string
+}
+
+// Group represents the group of rows, such as the rows of the same owner or repository.
+type Group struct {
+	// Name is the group name.
+	Name string
+	// Link is the URL of the group.
+	Link string
+	// Rows is the rows of the group. Each row must have the same length to Report.Columns.
+	Rows [][]Cell
+}
+
+// Count represents the summary count.
+type Count struct {
+	Label string
+	Count int
+}
+
+// Report represents the HTML report.
+type Report struct {
+	// Title is the report title.
+	Title string
+	// Generated is the time the report generated.
+	Generated time.Time
+	// Columns is the column names of the tables.
+	Columns []string
+	// Groups is the grouped rows.
+	Groups []Group
+	// Summary is the summary counts shown on the top of the report.
+	Summary []Count
+}
+
+// Total returns the total number of rows in r.
+func (r *Report) Total() int {
+	n := 0
+	for _, g := range r.Groups {
+		n += len(g.Rows)
+	}
+	return n
+}
+
+// WriteHTML renders r as the standalone HTML document to w.
+// The styles and scripts are embedded in the document so that it has no external assets.
+func (r *Report) WriteHTML(w io.Writer) error {
+	for _, g := range r.Groups {
+		for i, row := range g.Rows {
+			if len(row) != len(r.Columns) {
+				return fmt.Errorf("report: %s group row %d has %d cells, but want %d", g.Name, i, len(row), len(r.Columns))
+			}
+		}
+	}
+	if r.Generated.IsZero() {
+		r.Generated = time.Now()
+	}
+
+	if err := tmpl.Execute(w, r); err != nil {
+		return fmt.Errorf("report: could not render HTML: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+(function () {
+  "use strict";
+
+  function cellKey(row, index) {
+    var cell = row.cells[index];
+    return cell.getAttribute("data-sort") || cell.textContent.trim();
+  }
+
+  function compare(a, b) {
+    var na = Number(a), nb = Number(b);
+    if (a !== "" && b !== "" && !isNaN(na) && !isNaN(nb)) {
+      return na - nb;
+    }
+    return a.localeCompare(b);
+  }
+
+  document.querySelectorAll("table.sortable").forEach(function (table) {
+    var headers = table.querySelectorAll("th");
+    headers.forEach(function (th, index) {
+      th.addEventListener("click", function () {
+        var desc = th.classList.contains("asc");
+        headers.forEach(function (h) { h.classList.remove("asc", "desc"); });
+        th.classList.add(desc ? "desc" : "asc");
+
+        var tbody = table.tBodies[0];
+        var rows = Array.prototype.slice.call(tbody.rows);
+        rows.sort(function (a, b) {
+          var c = compare(cellKey(a, index), cellKey(b, index));
+          return desc ? -c : c;
+        });
+        rows.forEach(function (row) { tbody.appendChild(row); });
+      });
+    });
+  });
+})();
@@ -0,0 +1,270 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package spin
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	spin "github.com/tj/go-spin"
+	color "github.com/zchee/color/v2"
+
+	"github.com/zchee/ghctl/pkg/term"
+)
+
+const (
+	// defaultInterval is the redraw interval of Progress.
+	defaultInterval = 100 * time.Millisecond
+
+	// plainInterval is the interval of the plain lines written to the non-terminal writer.
+	plainInterval = 5 * time.Second
+)
+
+// Task represents a determinate unit of work tracked by Progress.
+//
+// All methods of Task are safe for concurrent use, so paginators may update it from any goroutine.
+type Task struct {
+	name      string
+	total     int64
+	completed int64
+	start     time.Time
+}
+
+// SetTotal sets the total number of steps of t.
+func (t *Task) SetTotal(n int) {
+	atomic.StoreInt64(&t.total, int64(n))
+}
+
+// AddTotal adds n to the total number of steps of t.
+func (t *Task) AddTotal(n int) {
+	atomic.AddInt64(&t.total, int64(n))
+}
+
+// Add marks n steps of t as completed.
+func (t *Task) Add(n int) {
+	atomic.AddInt64(&t.completed, int64(n))
+}
+
+// Increment marks one step of t as completed.
+func (t *Task) Increment() {
+	t.Add(1)
+}
+
+// Total returns the total number of steps of t.
+func (t *Task) Total() int {
+	return int(atomic.LoadInt64(&t.total))
+}
+
+// Completed returns the number of completed steps of t.
+func (t *Task) Completed() int {
+	return int(atomic.LoadInt64(&t.completed))
+}
+
+// Done reports whether all steps of t are completed.
+func (t *Task) Done() bool {
+	total := t.Total()
+	return total > 0 && t.Completed() >= total
+}
+
+// ETA returns the estimated remaining time of t based on the average step duration so far.
+// It returns zero if there is not enough information yet.
+func (t *Task) ETA() time.Duration {
+	total, completed := t.Total(), t.Completed()
+	if completed == 0 || total <= completed {
+		return 0
+	}
+	per := time.Since(t.start) / time.Duration(completed)
+	return per * time.Duration(total-completed)
+}
+
+// Progress renders the progress of one or more concurrent tasks.
+//
+// A single task is rendered as one redrawn line, several tasks as one line per task.
+// If the writer is not the terminal, such as the redirected stderr or CI logs, the tasks are
+// written as the plain lines every plainInterval instead, without the cursor and color sequences.
+type Progress struct {
+	w         io.Writer
+	s         *spin.Spinner
+	tty       bool
+	mu        sync.Mutex
+	tasks     []*Task
+	lines     int
+	lastPlain time.Time
+	done      chan struct{}
+	stopped   chan struct{}
+
+	rateRemaining int64
+	rateLimit     int64
+}
+
+// NewProgress returns the new Progress which renders to w.
+func NewProgress(w io.Writer) *Progress {
+	s := spin.New()
+	s.Set(spin.Spin1)
+	return &Progress{
+		w:             w,
+		s:             s,
+		tty:           isTerminal(w),
+		rateRemaining: -1,
+	}
+}
+
+// AddTask adds the new task named name with total steps to p.
+// total may be zero if it is not known yet, and updated later by Task.SetTotal.
+func (p *Progress) AddTask(name string, total int) *Task {
+	t := &Task{
+		name:  name,
+		total: int64(total),
+		start: time.Now(),
+	}
+	p.mu.Lock()
+	p.tasks = append(p.tasks, t)
+	p.mu.Unlock()
+	return t
+}
+
+// SetRateLimit sets the remaining and limit of the GitHub API rate limit rendered by p.
+func (p *Progress) SetRateLimit(remaining, limit int) {
+	atomic.StoreInt64(&p.rateRemaining, int64(remaining))
+	atomic.StoreInt64(&p.rateLimit, int64(limit))
+}
+
+// Start starts redrawing p periodically until Stop is called.
+func (p *Progress) Start() {
+	p.lastPlain = time.Now() // the short operations write no plain lines
+	p.done = make(chan struct{})
+	p.stopped = make(chan struct{})
+	go func() {
+		defer close(p.stopped)
+		ticker := time.NewTicker(defaultInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-p.done:
+				return
+			case <-ticker.C:
+				p.Render()
+			}
+		}
+	}()
+}
+
+// Stop stops redrawing p and clears the rendered lines.
+func (p *Progress) Stop() {
+	if p.done != nil {
+		close(p.done)
+		<-p.stopped
+		p.done = nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.clear()
+}
+
+// Render redraws all tasks of p once.
+func (p *Progress) Render() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.tasks) == 0 {
+		return
+	}
+
+	if !p.tty {
+		p.renderPlain()
+		return
+	}
+
+	p.clear()
+	frame := p.s.Next()
+	for i, t := range p.tasks {
+		if i > 0 {
+			fmt.Fprint(p.w, "\n")
+		}
+		fmt.Fprint(p.w, p.line(t, frame))
+	}
+	p.lines = len(p.tasks)
+}
+
+// renderPlain writes all tasks of p as the plain lines at most once per plainInterval. p.mu must be held.
+func (p *Progress) renderPlain() {
+	now := time.Now()
+	if now.Sub(p.lastPlain) < plainInterval {
+		return
+	}
+	p.lastPlain = now
+	for _, t := range p.tasks {
+		fmt.Fprintln(p.w, p.plainLine(t))
+	}
+}
+
+// clear erases the lines drawn by the last Render. p.mu must be held.
+func (p *Progress) clear() {
+	clearLines(p.w, p.lines)
+	p.lines = 0
+}
+
+// clearLines erases the last n lines written to w.
+func clearLines(w io.Writer, n int) {
+	if n == 0 {
+		return
+	}
+	var sb strings.Builder
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			sb.WriteString("\x1b[1A") // cursor up
+		}
+		sb.WriteString("\r\x1b[2K") // erase the entire line
+	}
+	fmt.Fprint(w, sb.String())
+}
+
+func (p *Progress) line(t *Task, frame string) string {
+	var sb strings.Builder
+	sb.WriteString(color.BlueString(t.name))
+	sb.WriteByte(' ')
+	if t.Done() {
+		sb.WriteString("✓")
+	} else {
+		sb.WriteString(frame)
+	}
+	p.writeStatus(&sb, t)
+	return sb.String()
+}
+
+// plainLine returns the line of t without the spinner and color.
+func (p *Progress) plainLine(t *Task) string {
+	var sb strings.Builder
+	sb.WriteString(t.name)
+	p.writeStatus(&sb, t)
+	return sb.String()
+}
+
+// writeStatus writes the steps, ETA and rate limit of t to sb.
+func (p *Progress) writeStatus(sb *strings.Builder, t *Task) {
+	total, completed := t.Total(), t.Completed()
+	if total > 0 {
+		fmt.Fprintf(sb, " %d/%d (%d%%)", completed, total, completed*100/total)
+		if eta := t.ETA(); eta > 0 {
+			fmt.Fprintf(sb, " ETA %s", eta.Round(time.Second))
+		}
+	} else if completed > 0 {
+		fmt.Fprintf(sb, " %d", completed)
+	}
+	if remaining := atomic.LoadInt64(&p.rateRemaining); remaining >= 0 {
+		fmt.Fprintf(sb, " [rate limit: %d/%d]", remaining, atomic.LoadInt64(&p.rateLimit))
+	}
+}
+
+// isTerminal reports whether w is the terminal.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(interface{ Fd() uintptr })
+	return ok && term.IsTerminal(f.Fd())
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package spin
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	spin "github.com/tj/go-spin"
+)
+
+// Table renders the live table which lines are produced by the render function on each redraw.
+type Table struct {
+	w       io.Writer
+	s       *spin.Spinner
+	render  func(frame string) []string
+	mu      sync.Mutex
+	lines   int
+	done    chan struct{}
+	stopped chan struct{}
+}
+
+// NewTable returns the new Table which renders the lines returned by render to w.
+// render is called with the current spinner frame, which the rows of the running items can show.
+func NewTable(w io.Writer, render func(frame string) []string) *Table {
+	s := spin.New()
+	s.Set(spin.Spin1)
+	return &Table{
+		w:      w,
+		s:      s,
+		render: render,
+	}
+}
+
+// Start starts redrawing t periodically until Stop is called.
+func (t *Table) Start() {
+	t.done = make(chan struct{})
+	t.stopped = make(chan struct{})
+	go func() {
+		defer close(t.stopped)
+		ticker := time.NewTicker(defaultInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-t.done:
+				return
+			case <-ticker.C:
+				t.Render()
+			}
+		}
+	}()
+}
+
+// Stop stops redrawing t and clears the rendered lines, so the caller can write the final table to the other writer.
+func (t *Table) Stop() {
+	if t.done != nil {
+		close(t.done)
+		<-t.stopped
+		t.done = nil
+	}
+
+	t.mu.Lock()
+	clearLines(t.w, t.lines)
+	t.lines = 0
+	t.mu.Unlock()
+}
+
+// Render redraws t once.
+func (t *Table) Render() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	lines := t.render(t.s.Next())
+	clearLines(t.w, t.lines)
+	fmt.Fprint(t.w, strings.Join(lines, "\n"))
+	t.lines = len(lines)
+}
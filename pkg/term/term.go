@@ -0,0 +1,18 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package term provides the minimal terminal handling used by the interactive commands.
+package term
+
+import (
+	"errors"
+)
+
+// ErrUnsupported is returned if the terminal operation is not supported on the platform.
+var ErrUnsupported = errors.New("term: not supported on this platform")
+
+// State represents the state of the terminal.
+type State struct {
+	state state
+}
@@ -0,0 +1,30 @@
+// Copyright 2026 The ghctl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd
+
+package term
+
+type state struct{}
+
+// IsTerminal reports whether the fd is a terminal.
+func IsTerminal(fd uintptr) bool {
+	return false
+}
+
+// MakeRaw puts the terminal connected to the fd into raw mode and returns the previous state of the terminal.
+func MakeRaw(fd uintptr) (*State, error) {
+	return nil, ErrUnsupported
+}
+
+// Restore restores the terminal connected to the fd to the oldState.
+func Restore(fd uintptr, oldState *State) error {
+	return ErrUnsupported
+}
+
+// GetSize returns the visible dimensions of the terminal connected to the fd.
+func GetSize(fd uintptr) (width, height int, err error) {
+	return 0, 0, ErrUnsupported
+}